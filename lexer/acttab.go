@@ -29,7 +29,7 @@ var ActTab = ActionTable{
 		Ignore: "!whitespace",
 	},
 	ActionRow{ // S2
-		Accept: 0,
+		Accept: 59,
 		Ignore: "",
 	},
 	ActionRow{ // S3
@@ -37,75 +37,75 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S4
-		Accept: 14,
+		Accept: 58,
 		Ignore: "",
 	},
 	ActionRow{ // S5
-		Accept: 15,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S6
-		Accept: 34,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S7
-		Accept: 32,
+		Accept: 27,
 		Ignore: "",
 	},
 	ActionRow{ // S8
-		Accept: 10,
+		Accept: 28,
 		Ignore: "",
 	},
 	ActionRow{ // S9
-		Accept: 33,
+		Accept: 56,
 		Ignore: "",
 	},
 	ActionRow{ // S10
-		Accept: 37,
+		Accept: 55,
 		Ignore: "",
 	},
 	ActionRow{ // S11
-		Accept: 35,
+		Accept: 17,
 		Ignore: "",
 	},
 	ActionRow{ // S12
-		Accept: 36,
+		Accept: 44,
 		Ignore: "",
 	},
 	ActionRow{ // S13
-		Accept: 36,
+		Accept: 34,
 		Ignore: "",
 	},
 	ActionRow{ // S14
-		Accept: 9,
+		Accept: 57,
 		Ignore: "",
 	},
 	ActionRow{ // S15
-		Accept: 4,
+		Accept: 19,
 		Ignore: "",
 	},
 	ActionRow{ // S16
-		Accept: 29,
+		Accept: 19,
 		Ignore: "",
 	},
 	ActionRow{ // S17
-		Accept: 22,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S18
-		Accept: 28,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S19
-		Accept: 3,
+		Accept: 50,
 		Ignore: "",
 	},
 	ActionRow{ // S20
-		Accept: 16,
+		Accept: 9,
 		Ignore: "",
 	},
 	ActionRow{ // S21
-		Accept: 17,
+		Accept: 49,
 		Ignore: "",
 	},
 	ActionRow{ // S22
@@ -113,11 +113,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S23
-		Accept: 3,
+		Accept: 18,
 		Ignore: "",
 	},
 	ActionRow{ // S24
-		Accept: 3,
+		Accept: 20,
 		Ignore: "",
 	},
 	ActionRow{ // S25
@@ -145,39 +145,39 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S31
-		Accept: 18,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S32
-		Accept: 19,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S33
-		Accept: 30,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S34
-		Accept: 21,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S35
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S36
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S37
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S38
-		Accept: 31,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S39
-		Accept: 24,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S40
@@ -185,71 +185,71 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S41
-		Accept: 3,
+		Accept: 11,
 		Ignore: "",
 	},
 	ActionRow{ // S42
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S43
-		Accept: 25,
+		Accept: 12,
 		Ignore: "",
 	},
 	ActionRow{ // S44
-		Accept: 3,
+		Accept: 51,
 		Ignore: "",
 	},
 	ActionRow{ // S45
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S46
-		Accept: 3,
+		Accept: 70,
 		Ignore: "",
 	},
 	ActionRow{ // S47
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S48
-		Accept: 3,
+		Accept: 48,
 		Ignore: "",
 	},
 	ActionRow{ // S49
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S50
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S51
-		Accept: 0,
+		Accept: 67,
 		Ignore: "",
 	},
 	ActionRow{ // S52
-		Accept: -1,
-		Ignore: "!comment_line",
+		Accept: 0,
+		Ignore: "",
 	},
 	ActionRow{ // S53
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S54
-		Accept: 6,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S55
-		Accept: 3,
+		Accept: 54,
 		Ignore: "",
 	},
 	ActionRow{ // S56
-		Accept: 11,
+		Accept: 52,
 		Ignore: "",
 	},
 	ActionRow{ // S57
-		Accept: 3,
+		Accept: 53,
 		Ignore: "",
 	},
 	ActionRow{ // S58
@@ -265,7 +265,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S61
-		Accept: 8,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S62
@@ -277,19 +277,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S64
-		Accept: -1,
-		Ignore: "!comment_block",
+		Accept: 3,
+		Ignore: "",
 	},
 	ActionRow{ // S65
-		Accept: 26,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S66
-		Accept: 3,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S67
-		Accept: 5,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S68
@@ -305,19 +305,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S71
-		Accept: 13,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S72
-		Accept: 3,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S73
-		Accept: 12,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S74
-		Accept: 20,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S75
@@ -329,7 +329,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S77
-		Accept: 23,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S78
@@ -337,11 +337,407 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S79
-		Accept: 27,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S80
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S81
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S82
+		Accept: 37,
+		Ignore: "",
+	},
+	ActionRow{ // S83
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S84
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S85
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S86
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S87
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S88
+		Accept: 47,
+		Ignore: "",
+	},
+	ActionRow{ // S89
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S90
+		Accept: 71,
+		Ignore: "",
+	},
+	ActionRow{ // S91
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S92
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S93
+		Accept: -1,
+		Ignore: "!comment_line",
+	},
+	ActionRow{ // S94
+		Accept: 66,
+		Ignore: "",
+	},
+	ActionRow{ // S95
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S96
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S97
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S98
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S99
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S100
+		Accept: 62,
+		Ignore: "",
+	},
+	ActionRow{ // S101
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S102
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S103
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S104
+		Accept: 6,
+		Ignore: "",
+	},
+	ActionRow{ // S105
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S106
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S107
+		Accept: 38,
+		Ignore: "",
+	},
+	ActionRow{ // S108
+		Accept: 21,
+		Ignore: "",
+	},
+	ActionRow{ // S109
+		Accept: 60,
+		Ignore: "",
+	},
+	ActionRow{ // S110
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S111
+		Accept: 61,
+		Ignore: "",
+	},
+	ActionRow{ // S112
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S113
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S114
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S115
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S116
+		Accept: 29,
+		Ignore: "",
+	},
+	ActionRow{ // S117
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S118
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S119
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S120
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S121
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S122
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S123
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S124
+		Accept: 14,
+		Ignore: "",
+	},
+	ActionRow{ // S125
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S126
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S127
+		Accept: -1,
+		Ignore: "!comment_block",
+	},
+	ActionRow{ // S128
+		Accept: 23,
+		Ignore: "",
+	},
+	ActionRow{ // S129
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S130
+		Accept: 43,
+		Ignore: "",
+	},
+	ActionRow{ // S131
+		Accept: 65,
+		Ignore: "",
+	},
+	ActionRow{ // S132
+		Accept: 25,
+		Ignore: "",
+	},
+	ActionRow{ // S133
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S134
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S135
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S136
+		Accept: 41,
+		Ignore: "",
+	},
+	ActionRow{ // S137
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S138
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S139
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S140
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S141
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S142
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S143
+		Accept: 33,
+		Ignore: "",
+	},
+	ActionRow{ // S144
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S145
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S146
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S147
+		Accept: 39,
+		Ignore: "",
+	},
+	ActionRow{ // S148
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S149
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S150
+		Accept: 68,
+		Ignore: "",
+	},
+	ActionRow{ // S151
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S152
+		Accept: 26,
+		Ignore: "",
+	},
+	ActionRow{ // S153
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S154
+		Accept: 30,
+		Ignore: "",
+	},
+	ActionRow{ // S155
+		Accept: 16,
+		Ignore: "",
+	},
+	ActionRow{ // S156
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S157
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S158
+		Accept: 69,
+		Ignore: "",
+	},
+	ActionRow{ // S159
+		Accept: 22,
+		Ignore: "",
+	},
+	ActionRow{ // S160
+		Accept: 64,
+		Ignore: "",
+	},
+	ActionRow{ // S161
+		Accept: 32,
+		Ignore: "",
+	},
+	ActionRow{ // S162
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S163
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S164
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S165
+		Accept: 63,
+		Ignore: "",
+	},
+	ActionRow{ // S166
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S167
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S168
+		Accept: 36,
+		Ignore: "",
+	},
+	ActionRow{ // S169
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S170
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S171
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S172
+		Accept: 10,
+		Ignore: "",
+	},
+	ActionRow{ // S173
+		Accept: 46,
+		Ignore: "",
+	},
+	ActionRow{ // S174
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S175
+		Accept: 42,
+		Ignore: "",
+	},
+	ActionRow{ // S176
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S177
+		Accept: 45,
+		Ignore: "",
+	},
+	ActionRow{ // S178
 		Accept: 2,
 		Ignore: "",
 	},
+	ActionRow{ // S179
+		Accept: 31,
+		Ignore: "",
+	},
 }
@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 81
-	NumSymbols = 109
+	NumStates  = 180
+	NumSymbols = 246
 )
 
 type Lexer struct {
@@ -135,107 +135,244 @@ Lexer symbols:
 3: '.'
 4: '"'
 5: '"'
-6: 'p'
-7: 'r'
-8: 'o'
-9: 'g'
+6: '''
+7: '\'
+8: '''
+9: 'p'
 10: 'r'
-11: 'a'
-12: 'm'
-13: ';'
-14: 'm'
-15: 'a'
-16: 'i'
-17: 'n'
-18: 'e'
-19: 'n'
-20: 'd'
-21: 'v'
-22: 'a'
-23: 'r'
-24: ':'
-25: ','
-26: 'i'
-27: 'n'
-28: 't'
-29: 'f'
-30: 'l'
-31: 'o'
-32: 'a'
-33: 't'
-34: 'v'
-35: 'o'
-36: 'i'
-37: 'd'
-38: '('
-39: ')'
-40: '['
-41: ']'
-42: '{'
-43: '}'
-44: 'p'
-45: 'r'
-46: 'i'
-47: 'n'
-48: 't'
-49: '='
-50: 'w'
-51: 'h'
-52: 'i'
+11: 'o'
+12: 'g'
+13: 'r'
+14: 'a'
+15: 'm'
+16: ';'
+17: 'm'
+18: 'a'
+19: 'i'
+20: 'n'
+21: 'e'
+22: 'n'
+23: 'd'
+24: 't'
+25: 'y'
+26: 'p'
+27: 'e'
+28: '='
+29: 'r'
+30: 'e'
+31: 'c'
+32: 'o'
+33: 'r'
+34: 'd'
+35: '{'
+36: '}'
+37: ':'
+38: 'v'
+39: 'a'
+40: 'r'
+41: 'c'
+42: 'o'
+43: 'n'
+44: 's'
+45: 't'
+46: ','
+47: '['
+48: ']'
+49: 'i'
+50: 'n'
+51: 't'
+52: 'f'
 53: 'l'
-54: 'e'
-55: 'd'
-56: 'o'
-57: 'i'
-58: 'f'
-59: 'e'
+54: 'o'
+55: 'a'
+56: 't'
+57: 'b'
+58: 'o'
+59: 'o'
 60: 'l'
 61: 's'
-62: 'e'
+62: 't'
 63: 'r'
-64: 'e'
-65: 't'
-66: 'u'
-67: 'r'
-68: 'n'
-69: '>'
-70: '<'
-71: '!'
-72: '='
-73: '='
-74: '='
-75: '+'
-76: '-'
-77: '*'
-78: '/'
-79: ' '
-80: '\t'
-81: '\n'
-82: '\r'
-83: '/'
-84: '/'
-85: '\t'
-86: '\n'
-87: '\r'
-88: '/'
-89: '*'
-90: '\t'
-91: '\n'
-92: '\r'
-93: '*'
-94: '/'
-95: 'a'-'z'
-96: 'A'-'Z'
-97: 'a'-'z'
-98: 'A'-'Z'
-99: '0'-'9'
-100: '1'-'9'
-101: '0'-'9'
-102: '0'-'9'
-103: '0'-'9'
-104: ' '-'!'
-105: '#'-'~'
-106: ' '-'~'
-107: ' '-'~'
-108: .
+64: 'i'
+65: 'n'
+66: 'g'
+67: 'c'
+68: 'h'
+69: 'a'
+70: 'r'
+71: 'v'
+72: 'o'
+73: 'i'
+74: 'd'
+75: '('
+76: ')'
+77: 'r'
+78: 'e'
+79: 'f'
+80: 'b'
+81: 'r'
+82: 'e'
+83: 'a'
+84: 'k'
+85: 'c'
+86: 'o'
+87: 'n'
+88: 't'
+89: 'i'
+90: 'n'
+91: 'u'
+92: 'e'
+93: 'p'
+94: 'r'
+95: 'i'
+96: 'n'
+97: 't'
+98: 'r'
+99: 'e'
+100: 'a'
+101: 'd'
+102: '.'
+103: 'd'
+104: 'o'
+105: 'w'
+106: 'h'
+107: 'i'
+108: 'l'
+109: 'e'
+110: 't'
+111: 'o'
+112: 'f'
+113: 'o'
+114: 'r'
+115: 's'
+116: 't'
+117: 'e'
+118: 'p'
+119: 'i'
+120: 'f'
+121: 'e'
+122: 'l'
+123: 's'
+124: 'e'
+125: 's'
+126: 'w'
+127: 'i'
+128: 't'
+129: 'c'
+130: 'h'
+131: 'c'
+132: 'a'
+133: 's'
+134: 'e'
+135: '-'
+136: 'd'
+137: 'e'
+138: 'f'
+139: 'a'
+140: 'u'
+141: 'l'
+142: 't'
+143: 'r'
+144: 'e'
+145: 't'
+146: 'u'
+147: 'r'
+148: 'n'
+149: '|'
+150: '|'
+151: '&'
+152: '&'
+153: '>'
+154: '<'
+155: '!'
+156: '='
+157: '='
+158: '='
+159: '>'
+160: '='
+161: '<'
+162: '='
+163: '+'
+164: '*'
+165: '/'
+166: '%'
+167: '!'
+168: 'l'
+169: 'e'
+170: 'n'
+171: 'o'
+172: 'r'
+173: 'd'
+174: 'c'
+175: 'h'
+176: 'r'
+177: 'r'
+178: 'o'
+179: 'u'
+180: 'n'
+181: 'd'
+182: 'f'
+183: 'l'
+184: 'o'
+185: 'o'
+186: 'r'
+187: 'c'
+188: 'e'
+189: 'i'
+190: 'l'
+191: 'a'
+192: 'b'
+193: 's'
+194: 't'
+195: 'r'
+196: 'u'
+197: 'e'
+198: 'f'
+199: 'a'
+200: 'l'
+201: 's'
+202: 'e'
+203: '\t'
+204: '\'
+205: '\t'
+206: '\t'
+207: 'n'
+208: 't'
+209: '\'
+210: '''
+211: ' '
+212: '\t'
+213: '\n'
+214: '\r'
+215: '/'
+216: '/'
+217: '\t'
+218: '\n'
+219: '\r'
+220: '/'
+221: '*'
+222: '\t'
+223: '\n'
+224: '\r'
+225: '*'
+226: '/'
+227: 'a'-'z'
+228: 'A'-'Z'
+229: 'a'-'z'
+230: 'A'-'Z'
+231: '0'-'9'
+232: '1'-'9'
+233: '0'-'9'
+234: '0'-'9'
+235: '0'-'9'
+236: ' '-'!'
+237: '#'-'['
+238: ']'-\U0010ffff
+239: ' '-\U0010ffff
+240: ' '-'&'
+241: '('-'['
+242: ']'-\U0010ffff
+243: ' '-'~'
+244: ' '-'~'
+245: .
 */
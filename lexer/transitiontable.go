@@ -25,80 +25,100 @@ var TransTab = TransitionTable{
 			return 2
 		case r == 34: // ['"','"']
 			return 3
-		case r == 40: // ['(','(']
+		case r == 37: // ['%','%']
 			return 4
-		case r == 41: // [')',')']
+		case r == 38: // ['&','&']
 			return 5
-		case r == 42: // ['*','*']
+		case r == 39: // [''',''']
 			return 6
-		case r == 43: // ['+','+']
+		case r == 40: // ['(','(']
 			return 7
-		case r == 44: // [',',',']
+		case r == 41: // [')',')']
 			return 8
-		case r == 45: // ['-','-']
+		case r == 42: // ['*','*']
 			return 9
-		case r == 46: // ['.','.']
+		case r == 43: // ['+','+']
 			return 10
-		case r == 47: // ['/','/']
+		case r == 44: // [',',',']
 			return 11
-		case r == 48: // ['0','0']
+		case r == 45: // ['-','-']
 			return 12
-		case 49 <= r && r <= 57: // ['1','9']
+		case r == 46: // ['.','.']
 			return 13
-		case r == 58: // [':',':']
+		case r == 47: // ['/','/']
 			return 14
-		case r == 59: // [';',';']
+		case r == 48: // ['0','0']
 			return 15
-		case r == 60: // ['<','<']
+		case 49 <= r && r <= 57: // ['1','9']
 			return 16
-		case r == 61: // ['=','=']
+		case r == 58: // [':',':']
 			return 17
-		case r == 62: // ['>','>']
+		case r == 59: // [';',';']
 			return 18
-		case 65 <= r && r <= 90: // ['A','Z']
+		case r == 60: // ['<','<']
 			return 19
-		case r == 91: // ['[','[']
+		case r == 61: // ['=','=']
 			return 20
-		case r == 93: // [']',']']
+		case r == 62: // ['>','>']
 			return 21
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 91: // ['[','[']
+			return 23
+		case r == 93: // [']',']']
+			return 24
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 99: // ['a','c']
-			return 19
-		case r == 100: // ['d','d']
 			return 22
+		case r == 97: // ['a','a']
+			return 25
+		case r == 98: // ['b','b']
+			return 26
+		case r == 99: // ['c','c']
+			return 27
+		case r == 100: // ['d','d']
+			return 28
 		case r == 101: // ['e','e']
-			return 23
+			return 29
 		case r == 102: // ['f','f']
-			return 24
+			return 30
 		case 103 <= r && r <= 104: // ['g','h']
-			return 19
+			return 22
 		case r == 105: // ['i','i']
-			return 25
-		case 106 <= r && r <= 108: // ['j','l']
-			return 19
+			return 31
+		case 106 <= r && r <= 107: // ['j','k']
+			return 22
+		case r == 108: // ['l','l']
+			return 32
 		case r == 109: // ['m','m']
-			return 26
-		case 110 <= r && r <= 111: // ['n','o']
-			return 19
+			return 33
+		case r == 110: // ['n','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 34
 		case r == 112: // ['p','p']
-			return 27
+			return 35
 		case r == 113: // ['q','q']
-			return 19
+			return 22
 		case r == 114: // ['r','r']
-			return 28
-		case 115 <= r && r <= 117: // ['s','u']
-			return 19
+			return 36
+		case r == 115: // ['s','s']
+			return 37
+		case r == 116: // ['t','t']
+			return 38
+		case r == 117: // ['u','u']
+			return 22
 		case r == 118: // ['v','v']
-			return 29
+			return 39
 		case r == 119: // ['w','w']
-			return 30
+			return 40
 		case 120 <= r && r <= 122: // ['x','z']
-			return 19
+			return 22
 		case r == 123: // ['{','{']
-			return 31
+			return 41
+		case r == 124: // ['|','|']
+			return 42
 		case r == 125: // ['}','}']
-			return 32
+			return 43
 		}
 		return NoState
 	},
@@ -112,19 +132,25 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 33
+			return 44
 		}
 		return NoState
 	},
 	// S3
 	func(r rune) int {
 		switch {
+		case r == 9: // ['\t','\t']
+			return 45
 		case 32 <= r && r <= 33: // [' ','!']
-			return 3
+			return 45
 		case r == 34: // ['"','"']
-			return 34
-		case 35 <= r && r <= 126: // ['#','~']
-			return 3
+			return 46
+		case 35 <= r && r <= 91: // ['#','[']
+			return 45
+		case r == 92: // ['\','\']
+			return 47
+		case 93 <= r && r <= 1114111: // [']',\U0010ffff]
+			return 45
 		}
 		return NoState
 	},
@@ -137,12 +163,24 @@ var TransTab = TransitionTable{
 	// S5
 	func(r rune) int {
 		switch {
+		case r == 38: // ['&','&']
+			return 48
 		}
 		return NoState
 	},
 	// S6
 	func(r rune) int {
 		switch {
+		case r == 9: // ['\t','\t']
+			return 49
+		case 32 <= r && r <= 38: // [' ','&']
+			return 49
+		case 40 <= r && r <= 91: // ['(','[']
+			return 49
+		case r == 92: // ['\','\']
+			return 50
+		case 93 <= r && r <= 1114111: // [']',\U0010ffff]
+			return 49
 		}
 		return NoState
 	},
@@ -167,64 +205,62 @@ var TransTab = TransitionTable{
 	// S10
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 10
 		}
 		return NoState
 	},
 	// S11
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 35
-		case r == 47: // ['/','/']
-			return 36
 		}
 		return NoState
 	},
 	// S12
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 10
-		case 48 <= r && r <= 57: // ['0','9']
-			return 37
 		}
 		return NoState
 	},
 	// S13
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 10
 		case 48 <= r && r <= 57: // ['0','9']
-			return 13
+			return 51
 		}
 		return NoState
 	},
 	// S14
 	func(r rune) int {
 		switch {
+		case r == 42: // ['*','*']
+			return 52
+		case r == 47: // ['/','/']
+			return 53
 		}
 		return NoState
 	},
 	// S15
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 54
 		}
 		return NoState
 	},
 	// S16
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 16
 		}
 		return NoState
 	},
 	// S17
 	func(r rune) int {
 		switch {
-		case r == 61: // ['=','=']
-			return 38
 		}
 		return NoState
 	},
@@ -237,26 +273,24 @@ var TransTab = TransitionTable{
 	// S19
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 19
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+		case r == 61: // ['=','=']
+			return 55
 		}
 		return NoState
 	},
 	// S20
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 56
 		}
 		return NoState
 	},
 	// S21
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 57
 		}
 		return NoState
 	},
@@ -264,57 +298,25 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 110: // ['a','n']
-			return 19
-		case r == 111: // ['o','o']
-			return 39
-		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
 	// S23
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 19
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 107: // ['a','k']
-			return 19
-		case r == 108: // ['l','l']
-			return 40
-		case r == 109: // ['m','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 41
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
 		}
 		return NoState
 	},
 	// S24
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 19
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 107: // ['a','k']
-			return 19
-		case r == 108: // ['l','l']
-			return 42
-		case 109 <= r && r <= 122: // ['m','z']
-			return 19
 		}
 		return NoState
 	},
@@ -322,879 +324,2511 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 101: // ['a','e']
-			return 19
-		case r == 102: // ['f','f']
-			return 43
-		case 103 <= r && r <= 109: // ['g','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 44
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
-		}
-		return NoState
-	},
-	// S26
-	func(r rune) int {
-		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
+			return 22
 		case r == 97: // ['a','a']
-			return 45
-		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 22
+		case r == 98: // ['b','b']
+			return 58
+		case 99 <= r && r <= 122: // ['c','z']
+			return 22
 		}
 		return NoState
 	},
-	// S27
+	// S26
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
+			return 22
+		case 97 <= r && r <= 110: // ['a','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 59
+		case 112 <= r && r <= 113: // ['p','q']
+			return 22
 		case r == 114: // ['r','r']
-			return 46
+			return 60
 		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 22
 		}
 		return NoState
 	},
-	// S28
+	// S27
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 100: // ['a','d']
-			return 19
+			return 22
+		case r == 97: // ['a','a']
+			return 61
+		case 98 <= r && r <= 100: // ['b','d']
+			return 22
+		case r == 101: // ['e','e']
+			return 62
+		case 102 <= r && r <= 103: // ['f','g']
+			return 22
+		case r == 104: // ['h','h']
+			return 63
+		case 105 <= r && r <= 110: // ['i','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 64
+		case 112 <= r && r <= 122: // ['p','z']
+			return 22
+		}
+		return NoState
+	},
+	// S28
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 22
+		case r == 101: // ['e','e']
+			return 65
+		case 102 <= r && r <= 110: // ['f','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 66
+		case 112 <= r && r <= 122: // ['p','z']
+			return 22
+		}
+		return NoState
+	},
+	// S29
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 107: // ['a','k']
+			return 22
+		case r == 108: // ['l','l']
+			return 67
+		case r == 109: // ['m','m']
+			return 22
+		case r == 110: // ['n','n']
+			return 68
+		case 111 <= r && r <= 122: // ['o','z']
+			return 22
+		}
+		return NoState
+	},
+	// S30
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case r == 97: // ['a','a']
+			return 69
+		case 98 <= r && r <= 107: // ['b','k']
+			return 22
+		case r == 108: // ['l','l']
+			return 70
+		case 109 <= r && r <= 110: // ['m','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 71
+		case 112 <= r && r <= 122: // ['p','z']
+			return 22
+		}
+		return NoState
+	},
+	// S31
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 101: // ['a','e']
+			return 22
+		case r == 102: // ['f','f']
+			return 72
+		case 103 <= r && r <= 109: // ['g','m']
+			return 22
+		case r == 110: // ['n','n']
+			return 73
+		case 111 <= r && r <= 122: // ['o','z']
+			return 22
+		}
+		return NoState
+	},
+	// S32
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 22
+		case r == 101: // ['e','e']
+			return 74
+		case 102 <= r && r <= 122: // ['f','z']
+			return 22
+		}
+		return NoState
+	},
+	// S33
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case r == 97: // ['a','a']
+			return 75
+		case 98 <= r && r <= 122: // ['b','z']
+			return 22
+		}
+		return NoState
+	},
+	// S34
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 113: // ['a','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 76
+		case 115 <= r && r <= 122: // ['s','z']
+			return 22
+		}
+		return NoState
+	},
+	// S35
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 113: // ['a','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 77
+		case 115 <= r && r <= 122: // ['s','z']
+			return 22
+		}
+		return NoState
+	},
+	// S36
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 22
+		case r == 101: // ['e','e']
+			return 78
+		case 102 <= r && r <= 110: // ['f','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 79
+		case 112 <= r && r <= 122: // ['p','z']
+			return 22
+		}
+		return NoState
+	},
+	// S37
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 22
+		case r == 116: // ['t','t']
+			return 80
+		case 117 <= r && r <= 118: // ['u','v']
+			return 22
+		case r == 119: // ['w','w']
+			return 81
+		case 120 <= r && r <= 122: // ['x','z']
+			return 22
+		}
+		return NoState
+	},
+	// S38
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 110: // ['a','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 82
+		case 112 <= r && r <= 113: // ['p','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 83
+		case 115 <= r && r <= 120: // ['s','x']
+			return 22
+		case r == 121: // ['y','y']
+			return 84
+		case r == 122: // ['z','z']
+			return 22
+		}
+		return NoState
+	},
+	// S39
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case r == 97: // ['a','a']
+			return 85
+		case 98 <= r && r <= 110: // ['b','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 86
+		case 112 <= r && r <= 122: // ['p','z']
+			return 22
+		}
+		return NoState
+	},
+	// S40
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 103: // ['a','g']
+			return 22
+		case r == 104: // ['h','h']
+			return 87
+		case 105 <= r && r <= 122: // ['i','z']
+			return 22
+		}
+		return NoState
+	},
+	// S41
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S42
+	func(r rune) int {
+		switch {
+		case r == 124: // ['|','|']
+			return 88
+		}
+		return NoState
+	},
+	// S43
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S44
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S45
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 45
+		case 32 <= r && r <= 33: // [' ','!']
+			return 45
+		case r == 34: // ['"','"']
+			return 46
+		case 35 <= r && r <= 91: // ['#','[']
+			return 45
+		case r == 92: // ['\','\']
+			return 47
+		case 93 <= r && r <= 1114111: // [']',\U0010ffff]
+			return 45
+		}
+		return NoState
+	},
+	// S46
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S47
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 89
+		case 32 <= r && r <= 1114111: // [' ',\U0010ffff]
+			return 89
+		}
+		return NoState
+	},
+	// S48
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S49
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 90
+		}
+		return NoState
+	},
+	// S50
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 91
+		case r == 92: // ['\','\']
+			return 91
+		case r == 110: // ['n','n']
+			return 91
+		case r == 116: // ['t','t']
+			return 91
+		}
+		return NoState
+	},
+	// S51
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 51
+		}
+		return NoState
+	},
+	// S52
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 52
+		case r == 10: // ['\n','\n']
+			return 52
+		case r == 13: // ['\r','\r']
+			return 52
+		case 32 <= r && r <= 41: // [' ',')']
+			return 52
+		case r == 42: // ['*','*']
+			return 92
+		case 43 <= r && r <= 126: // ['+','~']
+			return 52
+		}
+		return NoState
+	},
+	// S53
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 53
+		case r == 10: // ['\n','\n']
+			return 93
+		case r == 13: // ['\r','\r']
+			return 93
+		case 32 <= r && r <= 126: // [' ','~']
+			return 53
+		}
+		return NoState
+	},
+	// S54
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 54
+		}
+		return NoState
+	},
+	// S55
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S56
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S57
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S58
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 114: // ['a','r']
+			return 22
+		case r == 115: // ['s','s']
+			return 94
+		case 116 <= r && r <= 122: // ['t','z']
+			return 22
+		}
+		return NoState
+	},
+	// S59
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 110: // ['a','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 95
+		case 112 <= r && r <= 122: // ['p','z']
+			return 22
+		}
+		return NoState
+	},
+	// S60
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 22
+		case r == 101: // ['e','e']
+			return 96
+		case 102 <= r && r <= 122: // ['f','z']
+			return 22
+		}
+		return NoState
+	},
+	// S61
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 114: // ['a','r']
+			return 22
+		case r == 115: // ['s','s']
+			return 97
+		case 116 <= r && r <= 122: // ['t','z']
+			return 22
+		}
+		return NoState
+	},
+	// S62
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 104: // ['a','h']
+			return 22
+		case r == 105: // ['i','i']
+			return 98
+		case 106 <= r && r <= 122: // ['j','z']
+			return 22
+		}
+		return NoState
+	},
+	// S63
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case r == 97: // ['a','a']
+			return 99
+		case 98 <= r && r <= 113: // ['b','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 100
+		case 115 <= r && r <= 122: // ['s','z']
+			return 22
+		}
+		return NoState
+	},
+	// S64
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 109: // ['a','m']
+			return 22
+		case r == 110: // ['n','n']
+			return 101
+		case 111 <= r && r <= 122: // ['o','z']
+			return 22
+		}
+		return NoState
+	},
+	// S65
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 101: // ['a','e']
+			return 22
+		case r == 102: // ['f','f']
+			return 102
+		case 103 <= r && r <= 122: // ['g','z']
+			return 22
+		}
+		return NoState
+	},
+	// S66
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S67
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 114: // ['a','r']
+			return 22
+		case r == 115: // ['s','s']
+			return 103
+		case 116 <= r && r <= 122: // ['t','z']
+			return 22
+		}
+		return NoState
+	},
+	// S68
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 99: // ['a','c']
+			return 22
+		case r == 100: // ['d','d']
+			return 104
+		case 101 <= r && r <= 122: // ['e','z']
+			return 22
+		}
+		return NoState
+	},
+	// S69
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 107: // ['a','k']
+			return 22
+		case r == 108: // ['l','l']
+			return 105
+		case 109 <= r && r <= 122: // ['m','z']
+			return 22
+		}
+		return NoState
+	},
+	// S70
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 110: // ['a','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 106
+		case 112 <= r && r <= 122: // ['p','z']
+			return 22
+		}
+		return NoState
+	},
+	// S71
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 113: // ['a','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 107
+		case 115 <= r && r <= 122: // ['s','z']
+			return 22
+		}
+		return NoState
+	},
+	// S72
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S73
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 22
+		case r == 116: // ['t','t']
+			return 108
+		case 117 <= r && r <= 122: // ['u','z']
+			return 22
+		}
+		return NoState
+	},
+	// S74
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 109: // ['a','m']
+			return 22
+		case r == 110: // ['n','n']
+			return 109
+		case 111 <= r && r <= 122: // ['o','z']
+			return 22
+		}
+		return NoState
+	},
+	// S75
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 104: // ['a','h']
+			return 22
+		case r == 105: // ['i','i']
+			return 110
+		case 106 <= r && r <= 122: // ['j','z']
+			return 22
+		}
+		return NoState
+	},
+	// S76
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 99: // ['a','c']
+			return 22
+		case r == 100: // ['d','d']
+			return 111
+		case 101 <= r && r <= 122: // ['e','z']
+			return 22
+		}
+		return NoState
+	},
+	// S77
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 104: // ['a','h']
+			return 22
+		case r == 105: // ['i','i']
+			return 112
+		case 106 <= r && r <= 110: // ['j','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 113
+		case 112 <= r && r <= 122: // ['p','z']
+			return 22
+		}
+		return NoState
+	},
+	// S78
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case r == 97: // ['a','a']
+			return 114
+		case r == 98: // ['b','b']
+			return 22
+		case r == 99: // ['c','c']
+			return 115
+		case 100 <= r && r <= 101: // ['d','e']
+			return 22
+		case r == 102: // ['f','f']
+			return 116
+		case 103 <= r && r <= 115: // ['g','s']
+			return 22
+		case r == 116: // ['t','t']
+			return 117
+		case 117 <= r && r <= 122: // ['u','z']
+			return 22
+		}
+		return NoState
+	},
+	// S79
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 116: // ['a','t']
+			return 22
+		case r == 117: // ['u','u']
+			return 118
+		case 118 <= r && r <= 122: // ['v','z']
+			return 22
+		}
+		return NoState
+	},
+	// S80
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 22
+		case r == 101: // ['e','e']
+			return 119
+		case 102 <= r && r <= 113: // ['f','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 120
+		case 115 <= r && r <= 122: // ['s','z']
+			return 22
+		}
+		return NoState
+	},
+	// S81
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 104: // ['a','h']
+			return 22
+		case r == 105: // ['i','i']
+			return 121
+		case 106 <= r && r <= 122: // ['j','z']
+			return 22
+		}
+		return NoState
+	},
+	// S82
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S83
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 116: // ['a','t']
+			return 22
+		case r == 117: // ['u','u']
+			return 122
+		case 118 <= r && r <= 122: // ['v','z']
+			return 22
+		}
+		return NoState
+	},
+	// S84
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 111: // ['a','o']
+			return 22
+		case r == 112: // ['p','p']
+			return 123
+		case 113 <= r && r <= 122: // ['q','z']
+			return 22
+		}
+		return NoState
+	},
+	// S85
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 113: // ['a','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 124
+		case 115 <= r && r <= 122: // ['s','z']
+			return 22
+		}
+		return NoState
+	},
+	// S86
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 104: // ['a','h']
+			return 22
+		case r == 105: // ['i','i']
+			return 125
+		case 106 <= r && r <= 122: // ['j','z']
+			return 22
+		}
+		return NoState
+	},
+	// S87
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 104: // ['a','h']
+			return 22
+		case r == 105: // ['i','i']
+			return 126
+		case 106 <= r && r <= 122: // ['j','z']
+			return 22
+		}
+		return NoState
+	},
+	// S88
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 45
+		case 32 <= r && r <= 33: // [' ','!']
+			return 45
+		case r == 34: // ['"','"']
+			return 46
+		case 35 <= r && r <= 91: // ['#','[']
+			return 45
+		case r == 92: // ['\','\']
+			return 47
+		case 93 <= r && r <= 1114111: // [']',\U0010ffff]
+			return 45
+		}
+		return NoState
+	},
+	// S90
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S91
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 90
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 52
+		case r == 10: // ['\n','\n']
+			return 52
+		case r == 13: // ['\r','\r']
+			return 52
+		case 32 <= r && r <= 41: // [' ',')']
+			return 52
+		case r == 42: // ['*','*']
+			return 92
+		case 43 <= r && r <= 46: // ['+','.']
+			return 52
+		case r == 47: // ['/','/']
+			return 127
+		case 48 <= r && r <= 126: // ['0','~']
+			return 52
+		}
+		return NoState
+	},
+	// S93
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S94
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S95
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 107: // ['a','k']
+			return 22
+		case r == 108: // ['l','l']
+			return 128
+		case 109 <= r && r <= 122: // ['m','z']
+			return 22
+		}
+		return NoState
+	},
+	// S96
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case r == 97: // ['a','a']
+			return 129
+		case 98 <= r && r <= 122: // ['b','z']
+			return 22
+		}
+		return NoState
+	},
+	// S97
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 22
+		case r == 101: // ['e','e']
+			return 130
+		case 102 <= r && r <= 122: // ['f','z']
+			return 22
+		}
+		return NoState
+	},
+	// S98
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 107: // ['a','k']
+			return 22
+		case r == 108: // ['l','l']
+			return 131
+		case 109 <= r && r <= 122: // ['m','z']
+			return 22
+		}
+		return NoState
+	},
+	// S99
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 113: // ['a','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 132
+		case 115 <= r && r <= 122: // ['s','z']
+			return 22
+		}
+		return NoState
+	},
+	// S100
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S101
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 114: // ['a','r']
+			return 22
+		case r == 115: // ['s','s']
+			return 133
+		case r == 116: // ['t','t']
+			return 134
+		case 117 <= r && r <= 122: // ['u','z']
+			return 22
+		}
+		return NoState
+	},
+	// S102
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case r == 97: // ['a','a']
+			return 135
+		case 98 <= r && r <= 122: // ['b','z']
+			return 22
+		}
+		return NoState
+	},
+	// S103
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 22
+		case r == 101: // ['e','e']
+			return 136
+		case 102 <= r && r <= 122: // ['f','z']
+			return 22
+		}
+		return NoState
+	},
+	// S104
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S105
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 114: // ['a','r']
+			return 22
+		case r == 115: // ['s','s']
+			return 137
+		case 116 <= r && r <= 122: // ['t','z']
+			return 22
+		}
+		return NoState
+	},
+	// S106
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case r == 97: // ['a','a']
+			return 138
+		case 98 <= r && r <= 110: // ['b','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 139
+		case 112 <= r && r <= 122: // ['p','z']
+			return 22
+		}
+		return NoState
+	},
+	// S107
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S108
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S109
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S110
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 109: // ['a','m']
+			return 22
+		case r == 110: // ['n','n']
+			return 140
+		case 111 <= r && r <= 122: // ['o','z']
+			return 22
+		}
+		return NoState
+	},
+	// S111
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S112
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 109: // ['a','m']
+			return 22
+		case r == 110: // ['n','n']
+			return 141
+		case 111 <= r && r <= 122: // ['o','z']
+			return 22
+		}
+		return NoState
+	},
+	// S113
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 102: // ['a','f']
+			return 22
+		case r == 103: // ['g','g']
+			return 142
+		case 104 <= r && r <= 122: // ['h','z']
+			return 22
+		}
+		return NoState
+	},
+	// S114
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 99: // ['a','c']
+			return 22
+		case r == 100: // ['d','d']
+			return 143
+		case 101 <= r && r <= 122: // ['e','z']
+			return 22
+		}
+		return NoState
+	},
+	// S115
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 110: // ['a','n']
+			return 22
+		case r == 111: // ['o','o']
+			return 144
+		case 112 <= r && r <= 122: // ['p','z']
+			return 22
+		}
+		return NoState
+	},
+	// S116
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S117
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 116: // ['a','t']
+			return 22
+		case r == 117: // ['u','u']
+			return 145
+		case 118 <= r && r <= 122: // ['v','z']
+			return 22
+		}
+		return NoState
+	},
+	// S118
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 109: // ['a','m']
+			return 22
+		case r == 110: // ['n','n']
+			return 146
+		case 111 <= r && r <= 122: // ['o','z']
+			return 22
+		}
+		return NoState
+	},
+	// S119
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 111: // ['a','o']
+			return 22
+		case r == 112: // ['p','p']
+			return 147
+		case 113 <= r && r <= 122: // ['q','z']
+			return 22
+		}
+		return NoState
+	},
+	// S120
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 104: // ['a','h']
+			return 22
+		case r == 105: // ['i','i']
+			return 148
+		case 106 <= r && r <= 122: // ['j','z']
+			return 22
+		}
+		return NoState
+	},
+	// S121
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 22
+		case r == 116: // ['t','t']
+			return 149
+		case 117 <= r && r <= 122: // ['u','z']
+			return 22
+		}
+		return NoState
+	},
+	// S122
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 22
 		case r == 101: // ['e','e']
-			return 47
+			return 150
 		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 22
+		}
+		return NoState
+	},
+	// S123
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 22
+		case r == 101: // ['e','e']
+			return 151
+		case 102 <= r && r <= 122: // ['f','z']
+			return 22
+		}
+		return NoState
+	},
+	// S124
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
+		}
+		return NoState
+	},
+	// S125
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 99: // ['a','c']
+			return 22
+		case r == 100: // ['d','d']
+			return 152
+		case 101 <= r && r <= 122: // ['e','z']
+			return 22
+		}
+		return NoState
+	},
+	// S126
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 107: // ['a','k']
+			return 22
+		case r == 108: // ['l','l']
+			return 153
+		case 109 <= r && r <= 122: // ['m','z']
+			return 22
+		}
+		return NoState
+	},
+	// S127
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 52
+		case r == 10: // ['\n','\n']
+			return 52
+		case r == 13: // ['\r','\r']
+			return 52
+		case 32 <= r && r <= 41: // [' ',')']
+			return 52
+		case r == 42: // ['*','*']
+			return 92
+		case 43 <= r && r <= 126: // ['+','~']
+			return 52
+		}
+		return NoState
+	},
+	// S128
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S29
+	// S129
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case r == 97: // ['a','a']
-			return 48
-		case 98 <= r && r <= 110: // ['b','n']
-			return 19
-		case r == 111: // ['o','o']
-			return 49
-		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 106: // ['a','j']
+			return 22
+		case r == 107: // ['k','k']
+			return 154
+		case 108 <= r && r <= 122: // ['l','z']
+			return 22
 		}
 		return NoState
 	},
-	// S30
+	// S130
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 103: // ['a','g']
-			return 19
-		case r == 104: // ['h','h']
-			return 50
-		case 105 <= r && r <= 122: // ['i','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S31
+	// S131
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S32
+	// S132
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S33
+	// S133
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 22
+		case r == 116: // ['t','t']
+			return 155
+		case 117 <= r && r <= 122: // ['u','z']
+			return 22
 		}
 		return NoState
 	},
-	// S34
+	// S134
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 104: // ['a','h']
+			return 22
+		case r == 105: // ['i','i']
+			return 156
+		case 106 <= r && r <= 122: // ['j','z']
+			return 22
 		}
 		return NoState
 	},
-	// S35
+	// S135
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 35
-		case r == 10: // ['\n','\n']
-			return 35
-		case r == 13: // ['\r','\r']
-			return 35
-		case 32 <= r && r <= 41: // [' ',')']
-			return 35
-		case r == 42: // ['*','*']
-			return 51
-		case 43 <= r && r <= 126: // ['+','~']
-			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 116: // ['a','t']
+			return 22
+		case r == 117: // ['u','u']
+			return 157
+		case 118 <= r && r <= 122: // ['v','z']
+			return 22
 		}
 		return NoState
 	},
-	// S36
+	// S136
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 36
-		case r == 10: // ['\n','\n']
-			return 52
-		case r == 13: // ['\r','\r']
-			return 52
-		case 32 <= r && r <= 126: // [' ','~']
-			return 36
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S37
+	// S137
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 10
 		case 48 <= r && r <= 57: // ['0','9']
-			return 37
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 22
+		case r == 101: // ['e','e']
+			return 158
+		case 102 <= r && r <= 122: // ['f','z']
+			return 22
 		}
 		return NoState
 	},
-	// S38
+	// S138
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 22
+		case r == 116: // ['t','t']
+			return 159
+		case 117 <= r && r <= 122: // ['u','z']
+			return 22
 		}
 		return NoState
 	},
-	// S39
+	// S139
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 113: // ['a','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 160
+		case 115 <= r && r <= 122: // ['s','z']
+			return 22
 		}
 		return NoState
 	},
-	// S40
+	// S140
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 114: // ['a','r']
-			return 19
-		case r == 115: // ['s','s']
-			return 53
-		case 116 <= r && r <= 122: // ['t','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S41
+	// S141
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 99: // ['a','c']
-			return 19
-		case r == 100: // ['d','d']
-			return 54
-		case 101 <= r && r <= 122: // ['e','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 22
+		case r == 116: // ['t','t']
+			return 161
+		case 117 <= r && r <= 122: // ['u','z']
+			return 22
 		}
 		return NoState
 	},
-	// S42
+	// S142
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 110: // ['a','n']
-			return 19
-		case r == 111: // ['o','o']
-			return 55
-		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 113: // ['a','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 162
+		case 115 <= r && r <= 122: // ['s','z']
+			return 22
 		}
 		return NoState
 	},
-	// S43
+	// S143
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
+			return 22
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
 		}
 		return NoState
 	},
-	// S44
+	// S144
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 56
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 113: // ['a','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 163
+		case 115 <= r && r <= 122: // ['s','z']
+			return 22
 		}
 		return NoState
 	},
-	// S45
+	// S145
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 104: // ['a','h']
-			return 19
-		case r == 105: // ['i','i']
-			return 57
-		case 106 <= r && r <= 122: // ['j','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 113: // ['a','q']
+			return 22
+		case r == 114: // ['r','r']
+			return 164
+		case 115 <= r && r <= 122: // ['s','z']
+			return 22
 		}
 		return NoState
 	},
-	// S46
+	// S146
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 104: // ['a','h']
-			return 19
-		case r == 105: // ['i','i']
-			return 58
-		case 106 <= r && r <= 110: // ['j','n']
-			return 19
-		case r == 111: // ['o','o']
-			return 59
-		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 99: // ['a','c']
+			return 22
+		case r == 100: // ['d','d']
+			return 165
+		case 101 <= r && r <= 122: // ['e','z']
+			return 22
 		}
 		return NoState
 	},
-	// S47
+	// S147
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 60
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S48
+	// S148
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 61
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 109: // ['a','m']
+			return 22
+		case r == 110: // ['n','n']
+			return 166
+		case 111 <= r && r <= 122: // ['o','z']
+			return 22
 		}
 		return NoState
 	},
-	// S49
+	// S149
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 104: // ['a','h']
-			return 19
-		case r == 105: // ['i','i']
-			return 62
-		case 106 <= r && r <= 122: // ['j','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 98: // ['a','b']
+			return 22
+		case r == 99: // ['c','c']
+			return 167
+		case 100 <= r && r <= 122: // ['d','z']
+			return 22
 		}
 		return NoState
 	},
-	// S50
+	// S150
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 104: // ['a','h']
-			return 19
-		case r == 105: // ['i','i']
-			return 63
-		case 106 <= r && r <= 122: // ['j','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S51
+	// S151
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 35
-		case r == 10: // ['\n','\n']
-			return 35
-		case r == 13: // ['\r','\r']
-			return 35
-		case 32 <= r && r <= 41: // [' ',')']
-			return 35
-		case r == 42: // ['*','*']
-			return 51
-		case 43 <= r && r <= 46: // ['+','.']
-			return 35
-		case r == 47: // ['/','/']
-			return 64
-		case 48 <= r && r <= 126: // ['0','~']
-			return 35
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S52
+	// S152
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 22
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 22
+		case r == 95: // ['_','_']
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S53
+	// S153
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
+			return 22
 		case 97 <= r && r <= 100: // ['a','d']
-			return 19
+			return 22
 		case r == 101: // ['e','e']
-			return 65
+			return 168
 		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 22
 		}
 		return NoState
 	},
-	// S54
+	// S154
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
+			return 22
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
 		}
 		return NoState
 	},
-	// S55
+	// S155
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case r == 97: // ['a','a']
-			return 66
-		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S56
+	// S156
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 109: // ['a','m']
+			return 22
+		case r == 110: // ['n','n']
+			return 169
+		case 111 <= r && r <= 122: // ['o','z']
+			return 22
 		}
 		return NoState
 	},
-	// S57
+	// S157
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 109: // ['a','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 67
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 107: // ['a','k']
+			return 22
+		case r == 108: // ['l','l']
+			return 170
+		case 109 <= r && r <= 122: // ['m','z']
+			return 22
 		}
 		return NoState
 	},
-	// S58
+	// S158
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 109: // ['a','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 68
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S59
+	// S159
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 102: // ['a','f']
-			return 19
-		case r == 103: // ['g','g']
-			return 69
-		case 104 <= r && r <= 122: // ['h','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S60
+	// S160
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 116: // ['a','t']
-			return 19
-		case r == 117: // ['u','u']
-			return 70
-		case 118 <= r && r <= 122: // ['v','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S61
+	// S161
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
+			return 22
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
 		}
 		return NoState
 	},
-	// S62
+	// S162
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 99: // ['a','c']
-			return 19
-		case r == 100: // ['d','d']
-			return 71
-		case 101 <= r && r <= 122: // ['e','z']
-			return 19
+			return 22
+		case r == 97: // ['a','a']
+			return 171
+		case 98 <= r && r <= 122: // ['b','z']
+			return 22
 		}
 		return NoState
 	},
-	// S63
+	// S163
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 107: // ['a','k']
-			return 19
-		case r == 108: // ['l','l']
-			return 72
-		case 109 <= r && r <= 122: // ['m','z']
-			return 19
-		}
-		return NoState
-	},
-	// S64
-	func(r rune) int {
-		switch {
-		case r == 9: // ['\t','\t']
-			return 35
-		case r == 10: // ['\n','\n']
-			return 35
-		case r == 13: // ['\r','\r']
-			return 35
-		case 32 <= r && r <= 41: // [' ',')']
-			return 35
-		case r == 42: // ['*','*']
-			return 51
-		case 43 <= r && r <= 126: // ['+','~']
-			return 35
+			return 22
+		case 97 <= r && r <= 99: // ['a','c']
+			return 22
+		case r == 100: // ['d','d']
+			return 172
+		case 101 <= r && r <= 122: // ['e','z']
+			return 22
 		}
 		return NoState
 	},
-	// S65
+	// S164
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 109: // ['a','m']
+			return 22
+		case r == 110: // ['n','n']
+			return 173
+		case 111 <= r && r <= 122: // ['o','z']
+			return 22
 		}
 		return NoState
 	},
-	// S66
+	// S165
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 73
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S67
+	// S166
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 102: // ['a','f']
+			return 22
+		case r == 103: // ['g','g']
+			return 174
+		case 104 <= r && r <= 122: // ['h','z']
+			return 22
 		}
 		return NoState
 	},
-	// S68
+	// S167
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 74
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 103: // ['a','g']
+			return 22
+		case r == 104: // ['h','h']
+			return 175
+		case 105 <= r && r <= 122: // ['i','z']
+			return 22
 		}
 		return NoState
 	},
-	// S69
+	// S168
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 75
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S70
+	// S169
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 76
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 116: // ['a','t']
+			return 22
+		case r == 117: // ['u','u']
+			return 176
+		case 118 <= r && r <= 122: // ['v','z']
+			return 22
 		}
 		return NoState
 	},
-	// S71
+	// S170
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 115: // ['a','s']
+			return 22
+		case r == 116: // ['t','t']
+			return 177
+		case 117 <= r && r <= 122: // ['u','z']
+			return 22
 		}
 		return NoState
 	},
-	// S72
+	// S171
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 100: // ['a','d']
-			return 19
-		case r == 101: // ['e','e']
-			return 77
-		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 108: // ['a','l']
+			return 22
+		case r == 109: // ['m','m']
+			return 178
+		case 110 <= r && r <= 122: // ['n','z']
+			return 22
 		}
 		return NoState
 	},
-	// S73
+	// S172
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
+			return 22
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
 		}
 		return NoState
 	},
-	// S74
+	// S173
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
+			return 22
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
 		}
 		return NoState
 	},
-	// S75
+	// S174
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case r == 97: // ['a','a']
-			return 78
-		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S76
+	// S175
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 109: // ['a','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 79
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S77
+	// S176
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 100: // ['a','d']
+			return 22
+		case r == 101: // ['e','e']
+			return 179
+		case 102 <= r && r <= 122: // ['f','z']
+			return 22
 		}
 		return NoState
 	},
-	// S78
+	// S177
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 108: // ['a','l']
-			return 19
-		case r == 109: // ['m','m']
-			return 80
-		case 110 <= r && r <= 122: // ['n','z']
-			return 19
+			return 22
+		case 97 <= r && r <= 122: // ['a','z']
+			return 22
 		}
 		return NoState
 	},
-	// S79
+	// S178
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
+			return 22
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
 		}
 		return NoState
 	},
-	// S80
+	// S179
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 19
+			return 22
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 22
 		case r == 95: // ['_','_']
-			return 19
+			return 22
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 22
 		}
 		return NoState
 	},
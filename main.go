@@ -1,7 +1,9 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,17 +14,182 @@ import (
 	"Patito/vm"
 )
 
+// Códigos de salida del CLI.
+const (
+	exitOK       = 0
+	exitUsage    = 1 // argumentos inválidos
+	exitSyntax   = 2 // error léxico o sintáctico
+	exitSemantic = 3 // error semántico
+	exitIO       = 4 // no se pudo leer o escribir un archivo
+	exitRuntime  = 5 // error durante la ejecución en la VM
+)
+
+const usage = `Patito - compilador y VM
+
+Uso:
+  patito <comando> [opciones] <archivo>
+
+Comandos:
+  check    <archivo.patito>             Verifica sintaxis y semántica
+  quads    <archivo.patito>             Muestra la fila de cuádruplos (por defecto)
+  compile  [-o salida] <archivo.patito> Genera un archivo .patitoc
+  run      <archivo.patito>             Compila y ejecuta en la VM de Go
+  disasm   <archivo.patitoc>            Muestra el contenido de un .patitoc
+  help                                  Muestra esta ayuda
+
+Usa "-" como archivo para leer de la entrada estándar.
+
+Códigos de salida:
+  0 éxito, 1 uso inválido, 2 error sintáctico, 3 error semántico,
+  4 error de E/S, 5 error de ejecución
+`
+
+// command describe un subcomando del CLI.
+type command struct {
+	name string
+	run  func(c *cli, args []string) int
+}
+
+var commands = []command{
+	{"check", (*cli).runCheck},
+	{"quads", (*cli).runQuads},
+	{"compile", (*cli).runCompile},
+	{"run", (*cli).runRun},
+	{"disasm", (*cli).runDisasm},
+}
+
+// cli agrupa la entrada y salidas estándar de una ejecución del CLI, para
+// poder probar los comandos sin procesos aparte.
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
 func main() {
-	var data []byte
-	var err error
+	os.Exit(dispatch(os.Args[1:], os.Stdin, isTerminal(os.Stdin), os.Stdout, os.Stderr))
+}
+
+// dispatch ejecuta el comando de args y regresa el código de salida.
+// stdinIsTerminal indica que la entrada estándar es una terminal y no trae
+// un programa.
+func dispatch(args []string, stdin io.Reader, stdinIsTerminal bool, stdout, stderr io.Writer) int {
+	c := &cli{stdin: stdin, stdout: stdout, stderr: stderr}
+	if len(args) == 0 {
+		// cat programa.patito | patito
+		if !stdinIsTerminal {
+			return c.runQuads([]string{"-"})
+		}
+		fmt.Fprint(c.stderr, usage)
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(c.stdout, usage)
+		return exitOK
+	}
 
-	filename := os.Args[1]
-	data, err = os.ReadFile(filename)
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(c, args[1:])
+		}
+	}
+
+	// Forma histórica: patito <archivo> [--compile|-c] [--verbose|-v] [salida]
+	return c.runLegacy(args)
+}
+
+// isTerminal indica si f es una terminal (un dispositivo de caracteres) y no
+// un pipe o un archivo redirigido.
+func isTerminal(f *os.File) bool {
+	stat, err := f.Stat()
+	return err == nil && stat.Mode()&os.ModeCharDevice != 0
+}
+
+func (c *cli) runLegacy(args []string) int {
+	compile := false
+	rest := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == "--compile" || arg == "-c" {
+			compile = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	if !compile {
+		return c.runQuads(rest)
+	}
+	// El segundo argumento posicional era el archivo de salida
+	compileArgs := make([]string, 0, len(rest)+2)
+	positional := 0
+	for _, arg := range rest {
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			positional++
+			if positional == 2 {
+				compileArgs = append(compileArgs, "-o", arg)
+				continue
+			}
+		}
+		compileArgs = append(compileArgs, arg)
+	}
+	return c.runCompile(compileArgs)
+}
+
+// newFlagSet crea el FlagSet de un subcomando con su propio texto de ayuda.
+func (c *cli) newFlagSet(name, synopsis string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Uso: patito %s %s\n", name, synopsis)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseArgs permite mezclar banderas y argumentos posicionales en cualquier orden.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	positional := make([]string, 0, 1)
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// singleInput valida que haya exactamente un archivo de entrada.
+func singleInput(fs *flag.FlagSet, args []string) (string, int) {
+	positional, err := parseArgs(fs, args)
+	if err == flag.ErrHelp {
+		return "", exitOK
+	}
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "read error: %v\n", err)
-		os.Exit(1)
+		return "", exitUsage
+	}
+	if len(positional) != 1 {
+		fs.Usage()
+		return "", exitUsage
 	}
+	return positional[0], -1
+}
 
+// readInput lee un archivo o la entrada estándar cuando el nombre es "-".
+func (c *cli) readInput(filename string) ([]byte, error) {
+	if filename == "-" {
+		return io.ReadAll(c.stdin)
+	}
+	return os.ReadFile(filename)
+}
+
+// compileSource parsea el fuente y regresa el contexto semántico con los cuádruplos generados.
+// filename sólo se usa para ubicar los diagnósticos.
+func compileSource(filename string, data []byte) (*semantic.Context, error) {
 	// Crear contexto semántico
 	ctx := semantic.NewContext()
 
@@ -33,57 +200,174 @@ func main() {
 	p := parser.NewParser()
 	p.Context = ctx
 
-	// Parsear
-	if _, err := p.Parse(lexer.NewLexer(data)); err != nil {
-		fmt.Fprintln(os.Stderr, "parse error:", err)
-		os.Exit(1)
+	l := lexer.NewLexer(data)
+	if filename == "-" {
+		filename = "<stdin>"
 	}
+	l.Context = &lexer.SourceContext{Filepath: filename}
 
-	compile := false
-	verbose := false
-	outputFile := ""
+	// Parsear; los errores semánticos y sintácticos recuperables se acumulan en el contexto
+	_, err := p.Parse(l)
+	if diags := parser.DiagnoseAll(ctx, err, data); diags != nil {
+		return nil, diags
+	}
+	return ctx, nil
+}
 
-	for i := 2; i < len(os.Args); i++ {
-		arg := os.Args[i]
-		if arg == "--compile" || arg == "-c" {
-			compile = true
-		} else if !strings.HasPrefix(arg, "-") {
-			outputFile = arg
-		}
-		if arg == "--verbose" || arg == "-v" {
-			verbose = true
-		}
+// compileInput lee y compila un archivo reportando errores en stderr.
+// Regresa -1 como código cuando todo salió bien.
+func (c *cli) compileInput(filename string) (*semantic.Context, int) {
+	data, err := c.readInput(filename)
+	if err != nil {
+		fmt.Fprintf(c.stderr, "read error: %v\n", err)
+		return nil, exitIO
 	}
 
-	if compile {
-		fmt.Print("Compiling...")
-		// Generar archivo .patitoc
-		if outputFile == "" {
-			// Generar nombre de salida basado en el archivo de entrada
-			baseName := strings.TrimSuffix(filename, filepath.Ext(filename))
-			outputFile = baseName + ".patitoc"
-			if verbose {
-				fmt.Printf("Filename will be: %s", outputFile)
+	ctx, err := compileSource(filename, data)
+	if err != nil {
+		code := exitSemantic
+		for _, diag := range err.(semantic.DiagnosticList) {
+			semantic.RenderDiagnostic(c.stderr, diag, data)
+			if diag.Code == semantic.CodeSyntax {
+				code = exitSyntax
 			}
 		}
+		return nil, code
+	}
+	return ctx, -1
+}
 
-		if err := vm.SavePatitoc(ctx, outputFile); err != nil {
-			fmt.Fprintf(os.Stderr, "error generating .patitoc: %v\n", err)
-			os.Exit(1)
-		}
+func (c *cli) runCheck(args []string) int {
+	fs := c.newFlagSet("check", "<archivo.patito>")
+	filename, code := singleInput(fs, args)
+	if code >= 0 {
+		return code
+	}
+	if _, code := c.compileInput(filename); code >= 0 {
+		return code
+	}
+	fmt.Fprintln(c.stdout, "OK: parsed Patito successfully")
+	return exitOK
+}
+
+func (c *cli) runQuads(args []string) int {
+	fs := c.newFlagSet("quads", "<archivo.patito>")
+	filename, code := singleInput(fs, args)
+	if code >= 0 {
+		return code
+	}
+	ctx, code := c.compileInput(filename)
+	if code >= 0 {
+		return code
+	}
+	fmt.Fprintln(c.stdout, "OK: parsed Patito successfully")
+	fmt.Fprintln(c.stdout)
+	fmt.Fprint(c.stdout, ctx.Quadruples.String())
+	return exitOK
+}
+
+func (c *cli) runCompile(args []string) int {
+	fs := c.newFlagSet("compile", "[-o salida.patitoc] [-v] <archivo.patito>")
+	var outputFile string
+	var verbose bool
+	fs.StringVar(&outputFile, "output", "", "archivo .patitoc de salida")
+	fs.StringVar(&outputFile, "o", "", "atajo de --output")
+	fs.BoolVar(&verbose, "verbose", false, "muestra la ruta de salida")
+	fs.BoolVar(&verbose, "v", false, "atajo de --verbose")
+
+	filename, code := singleInput(fs, args)
+	if code >= 0 {
+		return code
+	}
+	ctx, code := c.compileInput(filename)
+	if code >= 0 {
+		return code
+	}
 
-		if verbose {
-			fmt.Printf("✓ Compiled successfully: %s -> %s\n", filename, outputFile)
+	if outputFile == "" {
+		// Generar nombre de salida basado en el archivo de entrada (o en el programa si es stdin)
+		if filename == "-" {
+			outputFile = ctx.Directory.ProgramName + ".patitoc"
 		} else {
-			fmt.Printf("✓ Compiled successfully\n")
+			outputFile = strings.TrimSuffix(filename, filepath.Ext(filename)) + ".patitoc"
+		}
+	}
+
+	if err := vm.SavePatitoc(ctx, outputFile); err != nil {
+		fmt.Fprintf(c.stderr, "error generating .patitoc: %v\n", err)
+		return exitIO
+	}
+
+	if verbose {
+		fmt.Fprintf(c.stdout, "✓ Compiled successfully: %s -> %s\n", filename, outputFile)
+	} else {
+		fmt.Fprintf(c.stdout, "✓ Compiled successfully\n")
+	}
+	fmt.Fprintf(c.stdout, "  Quadruples: %d\n", ctx.Quadruples.Size())
+	fmt.Fprintf(c.stdout, "  Constants: %d\n", len(ctx.ConstantTable.Entries()))
+	fmt.Fprintf(c.stdout, "  Functions: %d\n", len(ctx.Directory.OrderedFunctions()))
+	return exitOK
+}
+
+func (c *cli) runRun(args []string) int {
+	fs := c.newFlagSet("run", "<archivo.patito>")
+	filename, code := singleInput(fs, args)
+	if code >= 0 {
+		return code
+	}
+	ctx, code := c.compileInput(filename)
+	if code >= 0 {
+		return code
+	}
+	// Con "-" el programa ya consumió la entrada estándar y read no tendría
+	// de dónde leer
+	if filename == "-" && usesRead(ctx) {
+		fmt.Fprintln(c.stderr, "run: el programa usa read y la entrada estándar ya se usó para leer el programa; guárdalo en un archivo")
+		return exitUsage
+	}
+
+	machine := vm.NewMachine(vm.ProgramFromContext(ctx))
+	machine.Output = c.stdout
+	machine.Input = c.stdin
+	if err := machine.Run(); err != nil {
+		fmt.Fprintln(c.stderr, "runtime error:", err)
+		return exitRuntime
+	}
+	return exitOK
+}
+
+// usesRead indica si el programa tiene algún read.
+func usesRead(ctx *semantic.Context) bool {
+	for _, q := range ctx.Quadruples.Get() {
+		if q.Operator == "READ" {
+			return true
 		}
-		fmt.Printf("  Quadruples: %d\n", ctx.Quadruples.Size())
-		fmt.Printf("  Constants: %d\n", len(ctx.ConstantTable.Entries()))
-		fmt.Printf("  Functions: %d\n", len(ctx.Directory.Functions))
+	}
+	return false
+}
+
+func (c *cli) runDisasm(args []string) int {
+	fs := c.newFlagSet("disasm", "<archivo.patitoc>")
+	filename, code := singleInput(fs, args)
+	if code >= 0 {
+		return code
+	}
+
+	var prog *vm.Program
+	var err error
+	if filename == "-" {
+		prog, err = vm.NewPatitocReader(c.stdin).Read()
 	} else {
-		// Modo por defecto: mostrar cuádruplos
-		fmt.Println("OK: parsed Patito successfully")
-		fmt.Println()
-		fmt.Print(ctx.Quadruples.String())
+		prog, err = vm.LoadPatitoc(filename)
+	}
+	if err != nil {
+		fmt.Fprintf(c.stderr, "read error: %v\n", err)
+		return exitIO
+	}
+
+	if err := vm.Disassemble(c.stdout, prog); err != nil {
+		fmt.Fprintf(c.stderr, "write error: %v\n", err)
+		return exitIO
 	}
+	return exitOK
 }
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	cliProgram = `program p; var x: int; main { read(x); print(x * 2); } end`
+	cliSyntax  = `program p; main { x = ; } end`
+	cliUnknown = `program p; main { y = 1; } end`
+	cliDivZero = `program p; var x: int; main { x = 1 / 0; } end`
+)
+
+// writeFiles crea los archivos en un directorio temporal y regresa su ruta.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	return dir
+}
+
+func TestDispatch_ExitCodes(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"ok.patito":      cliProgram,
+		"syntax.patito":  cliSyntax,
+		"unknown.patito": cliUnknown,
+		"divzero.patito": cliDivZero,
+		"basura.patitoc": "no es un patitoc",
+	})
+	path := func(name string) string { return filepath.Join(dir, name) }
+
+	cases := []struct {
+		name   string
+		args   []string
+		stdin  string
+		code   int
+		stdout string // fragmento esperado en stdout
+		stderr string // fragmento esperado en stderr
+	}{
+		{name: "help", args: []string{"--help"}, code: exitOK, stdout: "Códigos de salida"},
+		{name: "comando help", args: []string{"help"}, code: exitOK, stdout: "Uso:"},
+		{name: "ayuda de subcomando", args: []string{"check", "-h"}, code: exitOK, stderr: "Uso: patito check"},
+		{name: "check", args: []string{"check", path("ok.patito")}, code: exitOK, stdout: "OK"},
+		{name: "check desde stdin", args: []string{"check", "-"}, stdin: cliProgram, code: exitOK, stdout: "OK"},
+		{name: "check sin archivo", args: []string{"check"}, code: exitUsage, stderr: "Uso: patito check"},
+		{name: "check con dos archivos", args: []string{"check", path("ok.patito"), path("ok.patito")}, code: exitUsage},
+		{name: "bandera desconocida", args: []string{"compile", "--nada", path("ok.patito")}, code: exitUsage},
+		{name: "error sintáctico", args: []string{"check", path("syntax.patito")}, code: exitSyntax, stderr: "E0001"},
+		{name: "error sintáctico desde stdin", args: []string{"check", "-"}, stdin: cliSyntax, code: exitSyntax, stderr: "<stdin>:1:"},
+		{name: "error semántico", args: []string{"check", path("unknown.patito")}, code: exitSemantic, stderr: "E0100"},
+		{name: "archivo inexistente", args: []string{"check", path("nada.patito")}, code: exitIO, stderr: "read error"},
+		{name: "quads", args: []string{"quads", path("ok.patito")}, code: exitOK, stdout: "(READ, int, , 1000)"},
+		{name: "quads por stdin sin comando", stdin: cliProgram, code: exitOK, stdout: "(GOTO, main, , 1)"},
+		{name: "run", args: []string{"run", path("ok.patito")}, stdin: "21\n", code: exitOK, stdout: "42\n"},
+		{name: "run desde stdin", args: []string{"run", "-"}, stdin: `program p; main { print(1); } end`, code: exitOK, stdout: "1\n"},
+		{name: "run desde stdin con read", args: []string{"run", "-"}, stdin: cliProgram, code: exitUsage, stderr: "el programa usa read"},
+		{name: "error de ejecución", args: []string{"run", path("divzero.patito")}, code: exitRuntime, stderr: "runtime error"},
+		{name: "compile a directorio inexistente", args: []string{"compile", "-o", path("no/existe.patitoc"), path("ok.patito")}, code: exitIO, stderr: "error generating .patitoc"},
+		{name: "disasm inexistente", args: []string{"disasm", path("nada.patitoc")}, code: exitIO, stderr: "read error"},
+		{name: "disasm inválido", args: []string{"disasm", path("basura.patitoc")}, code: exitIO, stderr: "read error"},
+		{name: "forma histórica", args: []string{path("ok.patito")}, code: exitOK, stdout: "(PRINT"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := dispatch(tc.args, strings.NewReader(tc.stdin), false, &stdout, &stderr)
+			assert.Equal(t, tc.code, code, "stdout:\n%s\nstderr:\n%s", stdout.String(), stderr.String())
+			assert.Contains(t, stdout.String(), tc.stdout)
+			assert.Contains(t, stderr.String(), tc.stderr)
+		})
+	}
+}
+
+func TestDispatch_UsageWithoutInput(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, exitUsage, dispatch(nil, nil, true, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "Uso:")
+	assert.Empty(t, stdout.String())
+}
+
+func TestDispatch_CompileOutput(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"ok.patito": cliProgram})
+	src := filepath.Join(dir, "ok.patito")
+
+	cases := map[string]struct {
+		args []string
+		out  string
+	}{
+		"nombre por defecto": {[]string{"compile", src}, filepath.Join(dir, "ok.patitoc")},
+		"-o":                 {[]string{"compile", "-o", filepath.Join(dir, "corto.patitoc"), src}, filepath.Join(dir, "corto.patitoc")},
+		"--output al final":  {[]string{"compile", src, "--output", filepath.Join(dir, "largo.patitoc")}, filepath.Join(dir, "largo.patitoc")},
+		"forma histórica":    {[]string{src, "--compile", filepath.Join(dir, "viejo.patitoc")}, filepath.Join(dir, "viejo.patitoc")},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			require.Equal(t, exitOK, dispatch(tc.args, strings.NewReader(""), false, &stdout, &stderr), stderr.String())
+			assert.FileExists(t, tc.out)
+
+			// El .patitoc generado se puede desensamblar
+			stdout.Reset()
+			require.Equal(t, exitOK, dispatch([]string{"disasm", tc.out}, strings.NewReader(""), false, &stdout, &stderr), stderr.String())
+			assert.Contains(t, stdout.String(), "program p")
+		})
+	}
+}
+
+func TestDispatch_CompileFromStdin(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "stdin.patitoc")
+	var stdout, stderr bytes.Buffer
+	require.Equal(t, exitOK, dispatch([]string{"compile", "-o", out, "-"}, strings.NewReader(cliProgram), false, &stdout, &stderr), stderr.String())
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	stdout.Reset()
+	require.Equal(t, exitOK, dispatch([]string{"disasm", "-"}, bytes.NewReader(data), false, &stdout, &stderr), stderr.String())
+	assert.Contains(t, stdout.String(), "program p")
+}
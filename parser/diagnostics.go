@@ -0,0 +1,147 @@
+package parser
+
+import (
+	"errors"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	parseError "Patito/errors"
+	"Patito/lexer"
+	"Patito/semantic"
+	"Patito/token"
+)
+
+// Diagnose convierte el error que regresa Parse en un *semantic.Diagnostic.
+// Los errores de sintaxis de gocc se reportan con CodeSyntax en el token que
+// falló; los errores de las acciones semánticas conservan su código y, si no
+// traían posición, toman la del token donde se detuvo el parser.
+func Diagnose(err error) *semantic.Diagnostic {
+	if err == nil {
+		return nil
+	}
+
+	var perr *parseError.Error
+	if !errors.As(err, &perr) {
+		return semantic.AsDiagnostic(err)
+	}
+
+	if perr.Err == nil {
+		tokens := make([]string, len(perr.ExpectedTokens))
+		for i, tok := range perr.ExpectedTokens {
+			if !unicode.IsLetter(rune(tok[0])) {
+				tok = strconv.Quote(tok)
+			}
+			tokens[i] = tok
+		}
+		return semantic.NewDiagnostic(semantic.CodeSyntax, perr.ErrorToken.Pos, utf8.RuneCount(perr.ErrorToken.Lit),
+			"%s; got: %s", parseError.DescribeExpected(tokens), parseError.DescribeToken(perr.ErrorToken))
+	}
+
+	diag := semantic.AsDiagnostic(perr.Err)
+	if !diag.HasPosition() && perr.ErrorToken != nil {
+		positioned := *diag
+		positioned.SetPos(perr.ErrorToken.Pos, utf8.RuneCount(perr.ErrorToken.Lit))
+		diag = &positioned
+	}
+	return diag
+}
+
+// DiagnoseAll combina los diagnósticos acumulados en el contexto con el error
+// que haya detenido el parseo, ordenados por posición. Si ya había errores, un
+// error interno posterior se descarta porque suele ser consecuencia de ellos.
+// src es el fuente que se parseó: con él se recalculan los tokens esperados
+// de un error de sintaxis (ver expectedAtFailure).
+func DiagnoseAll(ctx *semantic.Context, err error, src []byte) semantic.DiagnosticList {
+	var perr *parseError.Error
+	if errors.As(err, &perr) && perr.Err == nil {
+		fixed := *perr
+		fixed.ExpectedTokens = expectedAtFailure(lexer.NewLexer(src))
+		err = &fixed
+	}
+
+	diags := append(semantic.DiagnosticList(nil), ctx.Diagnostics...)
+	if d := Diagnose(err); d != nil && (d.Code != semantic.CodeInternal || len(diags) == 0) {
+		diags = append(diags, d)
+	}
+	if len(diags) == 0 {
+		return nil
+	}
+	diags.Sort()
+	return diags
+}
+
+// reportSyntaxError registra el error de sintaxis del que se recuperó una
+// producción `error`. gocc calcula los tokens esperados después de descartar
+// estados, así que sólo se reporta el token inesperado.
+func reportSyntaxError(ctx *semantic.Context, attrib Attrib) {
+	perr, ok := attrib.(*parseError.Error)
+	if !ok || perr.ErrorToken == nil {
+		return
+	}
+	ctx.Report(semantic.NewDiagnostic(semantic.CodeSyntax, perr.ErrorToken.Pos, utf8.RuneCount(perr.ErrorToken.Lit),
+		"unexpected %s", parseError.DescribeToken(perr.ErrorToken)))
+}
+
+// expectedAtFailure repite el análisis sintáctico sin acciones semánticas,
+// con la misma recuperación que Parser.Error, y regresa los tokens que se
+// esperaban donde el análisis se detuvo. Cuando la recuperación llega al fin
+// del archivo, gocc calcula los esperados del error final después de
+// descartar estados y de desplazar `error`, así que sólo mencionaría ";"
+// (p. ej. en un programa sin `end`).
+func expectedAtFailure(scanner Scanner) []string {
+	states := []int{0}
+	next := scanner.Scan()
+	for {
+		top := states[len(states)-1]
+		action := actionTab[top].actions[next.Type]
+		if action == nil {
+			expected := expectedIn(top)
+
+			// Descartar estados hasta el primero que se recupera con `error`
+			rs := len(states) - 1
+			for rs > 0 && !actionTab[states[rs]].canRecover {
+				rs--
+			}
+			if actionTab[states[rs]].canRecover {
+				states = states[:rs+1]
+			}
+			shiftErr, ok := actionTab[states[len(states)-1]].actions[token.TokMap.Type("error")].(shift)
+			if !ok {
+				return expected
+			}
+			states = append(states, int(shiftErr))
+			for actionTab[int(shiftErr)].actions[next.Type] == nil {
+				if next.Type == token.EOF {
+					return expected
+				}
+				next = scanner.Scan()
+			}
+			continue
+		}
+
+		switch act := action.(type) {
+		case accept:
+			return nil
+		case shift:
+			states = append(states, int(act))
+			next = scanner.Scan()
+		case reduce:
+			prod := productionsTable[int(act)]
+			states = states[:len(states)-prod.NumSymbols]
+			states = append(states, gotoTab[states[len(states)-1]][prod.NTType])
+		}
+	}
+}
+
+// expectedIn lista los tokens con acción en el estado, sin el pseudo-token
+// `error` de la recuperación, que no es algo que se pueda escribir.
+func expectedIn(state int) []string {
+	var expected []string
+	for t, action := range actionTab[state].actions {
+		if action != nil && token.Type(t) != token.TokMap.Type("error") {
+			expected = append(expected, token.TokMap.Id(token.Type(t)))
+		}
+	}
+	return expected
+}
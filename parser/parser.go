@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 88
-	numStates      = 371
-	numSymbols     = 89
+	numProductions = 181
+	numStates      = 1331
+	numSymbols     = 166
 )
 
 // Stack
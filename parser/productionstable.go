@@ -38,7 +38,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `P_VAR : VARS	<<  >>`,
+		String: `P_VAR : GLOBAL_DECLS	<<  >>`,
 		Id:         "P_VAR",
 		NTType:     2,
 		Index:      2,
@@ -48,98 +48,98 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `P_VAR : empty	<<  >>`,
-		Id:         "P_VAR",
-		NTType:     2,
+		String: `GLOBAL_DECLS : GLOBAL_DECL GLOBAL_DECLS	<<  >>`,
+		Id:         "GLOBAL_DECLS",
+		NTType:     3,
 		Index:      3,
-		NumSymbols: 0,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `VARS : "var" FVAR_LIST	<<  >>`,
-		Id:         "VARS",
+		String: `GLOBAL_DECLS : empty	<<  >>`,
+		Id:         "GLOBAL_DECLS",
 		NTType:     3,
 		Index:      4,
-		NumSymbols: 2,
+		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[0], nil
+			return nil, nil
 		},
 	},
 	ProdTabEntry{
-		String: `FVAR_LIST : F_VAR FVAR_LIST	<<  >>`,
-		Id:         "FVAR_LIST",
+		String: `GLOBAL_DECL : DECL	<<  >>`,
+		Id:         "GLOBAL_DECL",
 		NTType:     4,
 		Index:      5,
-		NumSymbols: 2,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `FVAR_LIST : empty	<<  >>`,
-		Id:         "FVAR_LIST",
+		String: `GLOBAL_DECL : TYPE_DECL	<<  >>`,
+		Id:         "GLOBAL_DECL",
 		NTType:     4,
 		Index:      6,
-		NumSymbols: 0,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `F_VAR : id R_ID ":" TYPE ";"	<<  >>`,
-		Id:         "F_VAR",
+		String: `TYPE_DECL : "type" id "=" "record" "{" FIELDS "}" ";"	<<  >>`,
+		Id:         "TYPE_DECL",
 		NTType:     5,
 		Index:      7,
-		NumSymbols: 5,
+		NumSymbols: 8,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `R_ID : "," id R_ID	<<  >>`,
-		Id:         "R_ID",
+		String: `FIELDS : FIELD FIELDS	<<  >>`,
+		Id:         "FIELDS",
 		NTType:     6,
 		Index:      8,
-		NumSymbols: 3,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `R_ID : empty	<<  >>`,
-		Id:         "R_ID",
+		String: `FIELDS : FIELD	<<  >>`,
+		Id:         "FIELDS",
 		NTType:     6,
 		Index:      9,
-		NumSymbols: 0,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `TYPE : "int"	<<  >>`,
-		Id:         "TYPE",
+		String: `FIELD : id R_ID ":" TYPE ";"	<<  >>`,
+		Id:         "FIELD",
 		NTType:     7,
 		Index:      10,
-		NumSymbols: 1,
+		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `TYPE : "float"	<<  >>`,
-		Id:         "TYPE",
-		NTType:     7,
+		String: `DECLS : DECL DECLS	<<  >>`,
+		Id:         "DECLS",
+		NTType:     8,
 		Index:      11,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `P_FUNCS : empty	<<  >>`,
-		Id:         "P_FUNCS",
+		String: `DECLS : empty	<<  >>`,
+		Id:         "DECLS",
 		NTType:     8,
 		Index:      12,
 		NumSymbols: 0,
@@ -148,18 +148,18 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `P_FUNCS : FUNCS P_FUNCS	<<  >>`,
-		Id:         "P_FUNCS",
-		NTType:     8,
+		String: `DECL : VARS	<<  >>`,
+		Id:         "DECL",
+		NTType:     9,
 		Index:      13,
-		NumSymbols: 2,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `F_T : TYPE	<<  >>`,
-		Id:         "F_T",
+		String: `DECL : CONST_DECL	<<  >>`,
+		Id:         "DECL",
 		NTType:     9,
 		Index:      14,
 		NumSymbols: 1,
@@ -168,59 +168,59 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `F_T : "void"	<<  >>`,
-		Id:         "F_T",
-		NTType:     9,
+		String: `VARS : "var" FVAR_LIST	<<  >>`,
+		Id:         "VARS",
+		NTType:     10,
 		Index:      15,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `FUNCS : FUNC_HEADER FUNC_LOCALS BODY ";"	<<  >>`,
-		Id:         "FUNCS",
-		NTType:     10,
+		String: `FVAR_LIST : F_VAR FVAR_LIST	<<  >>`,
+		Id:         "FVAR_LIST",
+		NTType:     11,
 		Index:      16,
-		NumSymbols: 4,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `FUNC_HEADER : F_T id "(" S_T ")"	<<  >>`,
-		Id:         "FUNC_HEADER",
+		String: `FVAR_LIST : empty	<<  >>`,
+		Id:         "FVAR_LIST",
 		NTType:     11,
 		Index:      17,
-		NumSymbols: 5,
+		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[0], nil
+			return nil, nil
 		},
 	},
 	ProdTabEntry{
-		String: `FUNC_LOCALS : "[" S_V "]"	<<  >>`,
-		Id:         "FUNC_LOCALS",
+		String: `F_VAR : id R_ID ":" TYPE DIMS ";"	<<  >>`,
+		Id:         "F_VAR",
 		NTType:     12,
 		Index:      18,
-		NumSymbols: 3,
+		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `FUNC_LOCALS : empty	<<  >>`,
-		Id:         "FUNC_LOCALS",
+		String: `F_VAR : id R_ID ":" id ";"	<<  >>`,
+		Id:         "F_VAR",
 		NTType:     12,
 		Index:      19,
-		NumSymbols: 0,
+		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `S_T : I_T R_T	<<  >>`,
-		Id:         "S_T",
-		NTType:     13,
+		String: `F_VAR : SYNC ";"	<<  >>`,
+		Id:         "F_VAR",
+		NTType:     12,
 		Index:      20,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
@@ -228,49 +228,49 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `S_T : empty	<<  >>`,
-		Id:         "S_T",
+		String: `SYNC : error	<<  >>`,
+		Id:         "SYNC",
 		NTType:     13,
 		Index:      21,
-		NumSymbols: 0,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `R_T : "," I_T R_T	<<  >>`,
-		Id:         "R_T",
+		String: `CONST_DECL : CONST_HEAD "=" EXPRESSION ";"	<<  >>`,
+		Id:         "CONST_DECL",
 		NTType:     14,
 		Index:      22,
-		NumSymbols: 3,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `R_T : empty	<<  >>`,
-		Id:         "R_T",
-		NTType:     14,
+		String: `CONST_HEAD : "const" id ":" TYPE	<<  >>`,
+		Id:         "CONST_HEAD",
+		NTType:     15,
 		Index:      23,
-		NumSymbols: 0,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `S_V : VARS	<<  >>`,
-		Id:         "S_V",
-		NTType:     15,
+		String: `R_ID : "," id R_ID	<<  >>`,
+		Id:         "R_ID",
+		NTType:     16,
 		Index:      24,
-		NumSymbols: 1,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `S_V : empty	<<  >>`,
-		Id:         "S_V",
-		NTType:     15,
+		String: `R_ID : empty	<<  >>`,
+		Id:         "R_ID",
+		NTType:     16,
 		Index:      25,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
@@ -278,9 +278,9 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `I_T : id ":" TYPE	<<  >>`,
-		Id:         "I_T",
-		NTType:     16,
+		String: `DIMS : "[" cte_int "]"	<<  >>`,
+		Id:         "DIMS",
+		NTType:     17,
 		Index:      26,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
@@ -288,39 +288,39 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `BODY : "{" P_STAT "}"	<<  >>`,
-		Id:         "BODY",
+		String: `DIMS : "[" cte_int "]" "[" cte_int "]"	<<  >>`,
+		Id:         "DIMS",
 		NTType:     17,
 		Index:      27,
-		NumSymbols: 3,
+		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `P_STAT : STATEMENT P_STAT	<<  >>`,
-		Id:         "P_STAT",
-		NTType:     18,
+		String: `DIMS : empty	<<  >>`,
+		Id:         "DIMS",
+		NTType:     17,
 		Index:      28,
-		NumSymbols: 2,
+		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[0], nil
+			return nil, nil
 		},
 	},
 	ProdTabEntry{
-		String: `P_STAT : empty	<<  >>`,
-		Id:         "P_STAT",
+		String: `TYPE : "int"	<<  >>`,
+		Id:         "TYPE",
 		NTType:     18,
 		Index:      29,
-		NumSymbols: 0,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `STATEMENT : ASSIGN	<<  >>`,
-		Id:         "STATEMENT",
-		NTType:     19,
+		String: `TYPE : "float"	<<  >>`,
+		Id:         "TYPE",
+		NTType:     18,
 		Index:      30,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
@@ -328,9 +328,9 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `STATEMENT : CONDITION	<<  >>`,
-		Id:         "STATEMENT",
-		NTType:     19,
+		String: `TYPE : "bool"	<<  >>`,
+		Id:         "TYPE",
+		NTType:     18,
 		Index:      31,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
@@ -338,9 +338,9 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `STATEMENT : CYCLE	<<  >>`,
-		Id:         "STATEMENT",
-		NTType:     19,
+		String: `TYPE : "string"	<<  >>`,
+		Id:         "TYPE",
+		NTType:     18,
 		Index:      32,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
@@ -348,78 +348,78 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `STATEMENT : F_CALL ";"	<<  >>`,
-		Id:         "STATEMENT",
-		NTType:     19,
+		String: `TYPE : "char"	<<  >>`,
+		Id:         "TYPE",
+		NTType:     18,
 		Index:      33,
-		NumSymbols: 2,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `STATEMENT : PRINT	<<  >>`,
-		Id:         "STATEMENT",
+		String: `P_FUNCS : empty	<<  >>`,
+		Id:         "P_FUNCS",
 		NTType:     19,
 		Index:      34,
-		NumSymbols: 1,
+		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[0], nil
+			return nil, nil
 		},
 	},
 	ProdTabEntry{
-		String: `STATEMENT : RETURN	<<  >>`,
-		Id:         "STATEMENT",
+		String: `P_FUNCS : FUNCS P_FUNCS	<<  >>`,
+		Id:         "P_FUNCS",
 		NTType:     19,
 		Index:      35,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `STATEMENT : "[" P_STAT "]"	<<  >>`,
-		Id:         "STATEMENT",
-		NTType:     19,
+		String: `F_T : TYPE	<<  >>`,
+		Id:         "F_T",
+		NTType:     20,
 		Index:      36,
-		NumSymbols: 3,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `PRINT : "print" "(" PRINT_P ")" ";"	<<  >>`,
-		Id:         "PRINT",
+		String: `F_T : "void"	<<  >>`,
+		Id:         "F_T",
 		NTType:     20,
 		Index:      37,
-		NumSymbols: 5,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `PRINT_P : E_PRINT R_PRINT	<<  >>`,
-		Id:         "PRINT_P",
+		String: `FUNCS : FUNC_HEADER FUNC_LOCALS BODY ";"	<<  >>`,
+		Id:         "FUNCS",
 		NTType:     21,
 		Index:      38,
-		NumSymbols: 2,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `E_PRINT : EXPRESSION	<<  >>`,
-		Id:         "E_PRINT",
-		NTType:     22,
+		String: `FUNCS : FUNC_SIGNATURE ";"	<<  >>`,
+		Id:         "FUNCS",
+		NTType:     21,
 		Index:      39,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `E_PRINT : cte_string	<<  >>`,
-		Id:         "E_PRINT",
+		String: `FUNC_HEADER : FUNC_SIGNATURE	<<  >>`,
+		Id:         "FUNC_HEADER",
 		NTType:     22,
 		Index:      40,
 		NumSymbols: 1,
@@ -428,49 +428,49 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `R_PRINT : "," PRINT_P	<<  >>`,
-		Id:         "R_PRINT",
+		String: `FUNC_SIGNATURE : F_T id "(" S_T ")"	<<  >>`,
+		Id:         "FUNC_SIGNATURE",
 		NTType:     23,
 		Index:      41,
-		NumSymbols: 2,
+		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `R_PRINT : empty	<<  >>`,
-		Id:         "R_PRINT",
-		NTType:     23,
+		String: `FUNC_LOCALS : "[" S_V "]"	<<  >>`,
+		Id:         "FUNC_LOCALS",
+		NTType:     24,
 		Index:      42,
-		NumSymbols: 0,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `ASSIGN : id "=" EXPRESSION ";"	<<  >>`,
-		Id:         "ASSIGN",
+		String: `FUNC_LOCALS : empty	<<  >>`,
+		Id:         "FUNC_LOCALS",
 		NTType:     24,
 		Index:      43,
-		NumSymbols: 4,
+		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[0], nil
+			return nil, nil
 		},
 	},
 	ProdTabEntry{
-		String: `CYCLE : "while" "(" EXPRESSION ")" WHILE_MARK "do" BODY ";"	<<  >>`,
-		Id:         "CYCLE",
+		String: `S_T : I_T R_T	<<  >>`,
+		Id:         "S_T",
 		NTType:     25,
 		Index:      44,
-		NumSymbols: 8,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `WHILE_MARK : empty	<<  >>`,
-		Id:         "WHILE_MARK",
-		NTType:     26,
+		String: `S_T : empty	<<  >>`,
+		Id:         "S_T",
+		NTType:     25,
 		Index:      45,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
@@ -478,70 +478,740 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `CONDITION : "if" "(" EXPRESSION ")" IF_MARK BODY ";"	<<  >>`,
-		Id:         "CONDITION",
-		NTType:     27,
+		String: `R_T : "," I_T R_T	<<  >>`,
+		Id:         "R_T",
+		NTType:     26,
 		Index:      46,
-		NumSymbols: 7,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `CONDITION : "if" "(" EXPRESSION ")" IF_MARK BODY ELSE_MARK "else" BODY ";"	<<  >>`,
-		Id:         "CONDITION",
-		NTType:     27,
+		String: `R_T : empty	<<  >>`,
+		Id:         "R_T",
+		NTType:     26,
 		Index:      47,
-		NumSymbols: 10,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `S_V : DECLS	<<  >>`,
+		Id:         "S_V",
+		NTType:     27,
+		Index:      48,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `I_T : id ":" TYPE	<<  >>`,
+		Id:         "I_T",
+		NTType:     28,
+		Index:      49,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `I_T : "ref" id ":" TYPE	<<  >>`,
+		Id:         "I_T",
+		NTType:     28,
+		Index:      50,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BODY : BLOCK_OPEN BLOCK_VARS P_STAT "}"	<<  >>`,
+		Id:         "BODY",
+		NTType:     29,
+		Index:      51,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BLOCK_OPEN : "{"	<<  >>`,
+		Id:         "BLOCK_OPEN",
+		NTType:     30,
+		Index:      52,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BRACKET_OPEN : "["	<<  >>`,
+		Id:         "BRACKET_OPEN",
+		NTType:     31,
+		Index:      53,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BLOCK_VARS : "var" F_VAR BLOCK_VARS	<<  >>`,
+		Id:         "BLOCK_VARS",
+		NTType:     32,
+		Index:      54,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BLOCK_VARS : empty	<<  >>`,
+		Id:         "BLOCK_VARS",
+		NTType:     32,
+		Index:      55,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `P_STAT : STATEMENT P_STAT	<<  >>`,
+		Id:         "P_STAT",
+		NTType:     33,
+		Index:      56,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `P_STAT : empty	<<  >>`,
+		Id:         "P_STAT",
+		NTType:     33,
+		Index:      57,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `STATEMENT : ASSIGN	<<  >>`,
+		Id:         "STATEMENT",
+		NTType:     34,
+		Index:      58,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `STATEMENT : CONDITION	<<  >>`,
+		Id:         "STATEMENT",
+		NTType:     34,
+		Index:      59,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `STATEMENT : CYCLE	<<  >>`,
+		Id:         "STATEMENT",
+		NTType:     34,
+		Index:      60,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `STATEMENT : F_CALL ";"	<<  >>`,
+		Id:         "STATEMENT",
+		NTType:     34,
+		Index:      61,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `STATEMENT : PRINT	<<  >>`,
+		Id:         "STATEMENT",
+		NTType:     34,
+		Index:      62,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `STATEMENT : READ	<<  >>`,
+		Id:         "STATEMENT",
+		NTType:     34,
+		Index:      63,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `STATEMENT : RETURN	<<  >>`,
+		Id:         "STATEMENT",
+		NTType:     34,
+		Index:      64,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `STATEMENT : "break" ";"	<<  >>`,
+		Id:         "STATEMENT",
+		NTType:     34,
+		Index:      65,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `STATEMENT : "continue" ";"	<<  >>`,
+		Id:         "STATEMENT",
+		NTType:     34,
+		Index:      66,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `STATEMENT : BRACKET_OPEN BLOCK_VARS P_STAT "]"	<<  >>`,
+		Id:         "STATEMENT",
+		NTType:     34,
+		Index:      67,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `STATEMENT : SYNC ";"	<<  >>`,
+		Id:         "STATEMENT",
+		NTType:     34,
+		Index:      68,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `PRINT : "print" "(" PRINT_P ")" ";"	<<  >>`,
+		Id:         "PRINT",
+		NTType:     35,
+		Index:      69,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `PRINT_P : E_PRINT R_PRINT	<<  >>`,
+		Id:         "PRINT_P",
+		NTType:     36,
+		Index:      70,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `E_PRINT : EXPRESSION	<<  >>`,
+		Id:         "E_PRINT",
+		NTType:     37,
+		Index:      71,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `R_PRINT : "," PRINT_P	<<  >>`,
+		Id:         "R_PRINT",
+		NTType:     38,
+		Index:      72,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `R_PRINT : empty	<<  >>`,
+		Id:         "R_PRINT",
+		NTType:     38,
+		Index:      73,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `READ : "read" "(" READ_LIST ")" ";"	<<  >>`,
+		Id:         "READ",
+		NTType:     39,
+		Index:      74,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `READ_LIST : READ_TARGET	<<  >>`,
+		Id:         "READ_LIST",
+		NTType:     40,
+		Index:      75,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `READ_LIST : READ_LIST "," READ_TARGET	<<  >>`,
+		Id:         "READ_LIST",
+		NTType:     40,
+		Index:      76,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `READ_TARGET : id	<<  >>`,
+		Id:         "READ_TARGET",
+		NTType:     41,
+		Index:      77,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `READ_TARGET : id "." id	<<  >>`,
+		Id:         "READ_TARGET",
+		NTType:     41,
+		Index:      78,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `IF_MARK : empty	<<  >>`,
-		Id:         "IF_MARK",
-		NTType:     28,
-		Index:      48,
+		String: `ASSIGN : id "=" EXPRESSION ";"	<<  >>`,
+		Id:         "ASSIGN",
+		NTType:     42,
+		Index:      79,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ASSIGN : id INDICES "=" EXPRESSION ";"	<<  >>`,
+		Id:         "ASSIGN",
+		NTType:     42,
+		Index:      80,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ASSIGN : id "." id "=" EXPRESSION ";"	<<  >>`,
+		Id:         "ASSIGN",
+		NTType:     42,
+		Index:      81,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CYCLE : WHILE_START "(" WHILE_COND ")" "do" BODY ";"	<<  >>`,
+		Id:         "CYCLE",
+		NTType:     43,
+		Index:      82,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CYCLE : FOR_HEAD "do" BODY ";"	<<  >>`,
+		Id:         "CYCLE",
+		NTType:     43,
+		Index:      83,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CYCLE : DO_START BODY DO_WHILE "(" DO_COND ")" ";"	<<  >>`,
+		Id:         "CYCLE",
+		NTType:     43,
+		Index:      84,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `WHILE_START : "while"	<<  >>`,
+		Id:         "WHILE_START",
+		NTType:     44,
+		Index:      85,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `WHILE_COND : EXPRESSION	<<  >>`,
+		Id:         "WHILE_COND",
+		NTType:     45,
+		Index:      86,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `DO_START : "do"	<<  >>`,
+		Id:         "DO_START",
+		NTType:     46,
+		Index:      87,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `DO_WHILE : "while"	<<  >>`,
+		Id:         "DO_WHILE",
+		NTType:     47,
+		Index:      88,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `DO_COND : EXPRESSION	<<  >>`,
+		Id:         "DO_COND",
+		NTType:     48,
+		Index:      89,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FOR_HEAD : FOR_INIT "to" EXPRESSION FOR_STEP	<<  >>`,
+		Id:         "FOR_HEAD",
+		NTType:     49,
+		Index:      90,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FOR_INIT : "for" id "=" EXPRESSION	<<  >>`,
+		Id:         "FOR_INIT",
+		NTType:     50,
+		Index:      91,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FOR_STEP : "step" EXPRESSION	<<  >>`,
+		Id:         "FOR_STEP",
+		NTType:     51,
+		Index:      92,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FOR_STEP : empty	<<  >>`,
+		Id:         "FOR_STEP",
+		NTType:     51,
+		Index:      93,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `CONDITION : "if" "(" IF_COND ")" BODY ELSE_CHAIN ";"	<<  >>`,
+		Id:         "CONDITION",
+		NTType:     52,
+		Index:      94,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CONDITION : SWITCH	<<  >>`,
+		Id:         "CONDITION",
+		NTType:     52,
+		Index:      95,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ELSE_CHAIN : empty	<<  >>`,
+		Id:         "ELSE_CHAIN",
+		NTType:     53,
+		Index:      96,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
 		},
 	},
+	ProdTabEntry{
+		String: `ELSE_CHAIN : ELSE_MARK "else" BODY	<<  >>`,
+		Id:         "ELSE_CHAIN",
+		NTType:     53,
+		Index:      97,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ELSE_CHAIN : ELSE_MARK "else" "if" "(" IF_COND ")" BODY ELSE_CHAIN	<<  >>`,
+		Id:         "ELSE_CHAIN",
+		NTType:     53,
+		Index:      98,
+		NumSymbols: 8,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `IF_COND : EXPRESSION	<<  >>`,
+		Id:         "IF_COND",
+		NTType:     54,
+		Index:      99,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String: `ELSE_MARK : empty	<<  >>`,
 		Id:         "ELSE_MARK",
-		NTType:     29,
-		Index:      49,
+		NTType:     55,
+		Index:      100,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `SWITCH : SWITCH_HEAD "{" CASES DEFAULT_CASE "}" ";"	<<  >>`,
+		Id:         "SWITCH",
+		NTType:     56,
+		Index:      101,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `SWITCH_HEAD : "switch" "(" EXPRESSION ")"	<<  >>`,
+		Id:         "SWITCH_HEAD",
+		NTType:     57,
+		Index:      102,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CASES : CASES CASE	<<  >>`,
+		Id:         "CASES",
+		NTType:     58,
+		Index:      103,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CASES : empty	<<  >>`,
+		Id:         "CASES",
+		NTType:     58,
+		Index:      104,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `CASE : CASE_LABEL P_STAT	<<  >>`,
+		Id:         "CASE",
+		NTType:     59,
+		Index:      105,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CASE_LABEL : "case" cte_int ":"	<<  >>`,
+		Id:         "CASE_LABEL",
+		NTType:     60,
+		Index:      106,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CASE_LABEL : "case" "-" cte_int ":"	<<  >>`,
+		Id:         "CASE_LABEL",
+		NTType:     60,
+		Index:      107,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `DEFAULT_CASE : DEFAULT_LABEL P_STAT	<<  >>`,
+		Id:         "DEFAULT_CASE",
+		NTType:     61,
+		Index:      108,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `DEFAULT_CASE : empty	<<  >>`,
+		Id:         "DEFAULT_CASE",
+		NTType:     61,
+		Index:      109,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
 		},
 	},
 	ProdTabEntry{
-		String: `RETURN : "return" EXPRESSION ";"	<<  >>`,
-		Id:         "RETURN",
-		NTType:     30,
-		Index:      50,
+		String: `DEFAULT_LABEL : "default" ":"	<<  >>`,
+		Id:         "DEFAULT_LABEL",
+		NTType:     62,
+		Index:      110,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `RETURN : "return" EXPRESSION ";"	<<  >>`,
+		Id:         "RETURN",
+		NTType:     63,
+		Index:      111,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `RETURN : "return" ";"	<<  >>`,
+		Id:         "RETURN",
+		NTType:     63,
+		Index:      112,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EXPRESSION : EXPRESSION OR_MARK AND_EXP	<<  >>`,
+		Id:         "EXPRESSION",
+		NTType:     64,
+		Index:      113,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EXPRESSION : AND_EXP	<<  >>`,
+		Id:         "EXPRESSION",
+		NTType:     64,
+		Index:      114,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `OR_MARK : "||"	<<  >>`,
+		Id:         "OR_MARK",
+		NTType:     65,
+		Index:      115,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `AND_EXP : AND_EXP AND_MARK REL_EXP	<<  >>`,
+		Id:         "AND_EXP",
+		NTType:     66,
+		Index:      116,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `RETURN : "return" ";"	<<  >>`,
-		Id:         "RETURN",
-		NTType:     30,
-		Index:      51,
-		NumSymbols: 2,
+		String: `AND_EXP : REL_EXP	<<  >>`,
+		Id:         "AND_EXP",
+		NTType:     66,
+		Index:      117,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `EXPRESSION : EXP REL_TAIL	<<  >>`,
-		Id:         "EXPRESSION",
-		NTType:     31,
-		Index:      52,
+		String: `AND_MARK : "&&"	<<  >>`,
+		Id:         "AND_MARK",
+		NTType:     67,
+		Index:      118,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `REL_EXP : EXP REL_TAIL	<<  >>`,
+		Id:         "REL_EXP",
+		NTType:     68,
+		Index:      119,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -550,8 +1220,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `REL_TAIL : REL_OP EXP	<<  >>`,
 		Id:         "REL_TAIL",
-		NTType:     32,
-		Index:      53,
+		NTType:     69,
+		Index:      120,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -560,8 +1230,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `REL_TAIL : empty	<<  >>`,
 		Id:         "REL_TAIL",
-		NTType:     32,
-		Index:      54,
+		NTType:     69,
+		Index:      121,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
@@ -570,8 +1240,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `REL_OP : ">"	<<  >>`,
 		Id:         "REL_OP",
-		NTType:     33,
-		Index:      55,
+		NTType:     70,
+		Index:      122,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -580,8 +1250,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `REL_OP : "<"	<<  >>`,
 		Id:         "REL_OP",
-		NTType:     33,
-		Index:      56,
+		NTType:     70,
+		Index:      123,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -590,8 +1260,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `REL_OP : "!="	<<  >>`,
 		Id:         "REL_OP",
-		NTType:     33,
-		Index:      57,
+		NTType:     70,
+		Index:      124,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -600,8 +1270,28 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `REL_OP : "=="	<<  >>`,
 		Id:         "REL_OP",
-		NTType:     33,
-		Index:      58,
+		NTType:     70,
+		Index:      125,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `REL_OP : ">="	<<  >>`,
+		Id:         "REL_OP",
+		NTType:     70,
+		Index:      126,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `REL_OP : "<="	<<  >>`,
+		Id:         "REL_OP",
+		NTType:     70,
+		Index:      127,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -610,8 +1300,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `EXP : TERMINO EXP_P	<<  >>`,
 		Id:         "EXP",
-		NTType:     34,
-		Index:      59,
+		NTType:     71,
+		Index:      128,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -620,8 +1310,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `EXP_P : ADD_MARK TERMINO EXP_P	<<  >>`,
 		Id:         "EXP_P",
-		NTType:     35,
-		Index:      60,
+		NTType:     72,
+		Index:      129,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -630,8 +1320,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `EXP_P : SUB_MARK TERMINO EXP_P	<<  >>`,
 		Id:         "EXP_P",
-		NTType:     35,
-		Index:      61,
+		NTType:     72,
+		Index:      130,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -640,8 +1330,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `EXP_P : empty	<<  >>`,
 		Id:         "EXP_P",
-		NTType:     35,
-		Index:      62,
+		NTType:     72,
+		Index:      131,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
@@ -650,8 +1340,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ADD_MARK : "+"	<<  >>`,
 		Id:         "ADD_MARK",
-		NTType:     36,
-		Index:      63,
+		NTType:     73,
+		Index:      132,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -660,8 +1350,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `SUB_MARK : "-"	<<  >>`,
 		Id:         "SUB_MARK",
-		NTType:     37,
-		Index:      64,
+		NTType:     74,
+		Index:      133,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -670,8 +1360,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `TERMINO : FACTOR TERMINO_P	<<  >>`,
 		Id:         "TERMINO",
-		NTType:     38,
-		Index:      65,
+		NTType:     75,
+		Index:      134,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -680,8 +1370,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `TERMINO_P : MUL_MARK FACTOR TERMINO_P	<<  >>`,
 		Id:         "TERMINO_P",
-		NTType:     39,
-		Index:      66,
+		NTType:     76,
+		Index:      135,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -690,8 +1380,18 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `TERMINO_P : DIV_MARK FACTOR TERMINO_P	<<  >>`,
 		Id:         "TERMINO_P",
-		NTType:     39,
-		Index:      67,
+		NTType:     76,
+		Index:      136,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `TERMINO_P : MOD_MARK FACTOR TERMINO_P	<<  >>`,
+		Id:         "TERMINO_P",
+		NTType:     76,
+		Index:      137,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -700,8 +1400,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `TERMINO_P : empty	<<  >>`,
 		Id:         "TERMINO_P",
-		NTType:     39,
-		Index:      68,
+		NTType:     76,
+		Index:      138,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
@@ -710,8 +1410,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `MUL_MARK : "*"	<<  >>`,
 		Id:         "MUL_MARK",
-		NTType:     40,
-		Index:      69,
+		NTType:     77,
+		Index:      139,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -720,8 +1420,18 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `DIV_MARK : "/"	<<  >>`,
 		Id:         "DIV_MARK",
-		NTType:     41,
-		Index:      70,
+		NTType:     78,
+		Index:      140,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `MOD_MARK : "%"	<<  >>`,
+		Id:         "MOD_MARK",
+		NTType:     79,
+		Index:      141,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -730,18 +1440,28 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FACTOR : S_OP FACTOR_CORE	<<  >>`,
 		Id:         "FACTOR",
-		NTType:     42,
-		Index:      71,
+		NTType:     80,
+		Index:      142,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FACTOR : "!" FACTOR	<<  >>`,
+		Id:         "FACTOR",
+		NTType:     80,
+		Index:      143,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `FACTOR_CORE : "(" EXPRESSION ")"	<<  >>`,
+		String: `FACTOR_CORE : PAREN_OPEN EXPRESSION ")"	<<  >>`,
 		Id:         "FACTOR_CORE",
-		NTType:     43,
-		Index:      72,
+		NTType:     81,
+		Index:      144,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -750,8 +1470,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FACTOR_CORE : id FACTOR_SUFFIX	<<  >>`,
 		Id:         "FACTOR_CORE",
-		NTType:     43,
-		Index:      73,
+		NTType:     81,
+		Index:      145,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -760,8 +1480,118 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FACTOR_CORE : CTE	<<  >>`,
 		Id:         "FACTOR_CORE",
-		NTType:     43,
-		Index:      74,
+		NTType:     81,
+		Index:      146,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FACTOR_CORE : BUILTIN PAREN_OPEN EXPRESSION ")"	<<  >>`,
+		Id:         "FACTOR_CORE",
+		NTType:     81,
+		Index:      147,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BUILTIN : "len"	<<  >>`,
+		Id:         "BUILTIN",
+		NTType:     82,
+		Index:      148,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BUILTIN : "ord"	<<  >>`,
+		Id:         "BUILTIN",
+		NTType:     82,
+		Index:      149,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BUILTIN : "chr"	<<  >>`,
+		Id:         "BUILTIN",
+		NTType:     82,
+		Index:      150,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BUILTIN : "int"	<<  >>`,
+		Id:         "BUILTIN",
+		NTType:     82,
+		Index:      151,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BUILTIN : "float"	<<  >>`,
+		Id:         "BUILTIN",
+		NTType:     82,
+		Index:      152,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BUILTIN : "round"	<<  >>`,
+		Id:         "BUILTIN",
+		NTType:     82,
+		Index:      153,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BUILTIN : "floor"	<<  >>`,
+		Id:         "BUILTIN",
+		NTType:     82,
+		Index:      154,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BUILTIN : "ceil"	<<  >>`,
+		Id:         "BUILTIN",
+		NTType:     82,
+		Index:      155,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BUILTIN : "abs"	<<  >>`,
+		Id:         "BUILTIN",
+		NTType:     82,
+		Index:      156,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `PAREN_OPEN : "("	<<  >>`,
+		Id:         "PAREN_OPEN",
+		NTType:     83,
+		Index:      157,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -770,28 +1600,88 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FACTOR_SUFFIX : CALL_ARGS_OPEN S_E ")"	<<  >>`,
 		Id:         "FACTOR_SUFFIX",
-		NTType:     44,
-		Index:      75,
+		NTType:     84,
+		Index:      158,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
+	ProdTabEntry{
+		String: `FACTOR_SUFFIX : INDICES	<<  >>`,
+		Id:         "FACTOR_SUFFIX",
+		NTType:     84,
+		Index:      159,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FACTOR_SUFFIX : "." id	<<  >>`,
+		Id:         "FACTOR_SUFFIX",
+		NTType:     84,
+		Index:      160,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String: `FACTOR_SUFFIX : empty	<<  >>`,
 		Id:         "FACTOR_SUFFIX",
-		NTType:     44,
-		Index:      76,
+		NTType:     84,
+		Index:      161,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
 		},
 	},
+	ProdTabEntry{
+		String: `INDICES : INDEX	<<  >>`,
+		Id:         "INDICES",
+		NTType:     85,
+		Index:      162,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `INDICES : INDEX INDEX	<<  >>`,
+		Id:         "INDICES",
+		NTType:     85,
+		Index:      163,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `INDEX : INDEX_OPEN EXPRESSION "]"	<<  >>`,
+		Id:         "INDEX",
+		NTType:     86,
+		Index:      164,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `INDEX_OPEN : "["	<<  >>`,
+		Id:         "INDEX_OPEN",
+		NTType:     87,
+		Index:      165,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String: `S_OP : "+"	<<  >>`,
 		Id:         "S_OP",
-		NTType:     45,
-		Index:      77,
+		NTType:     88,
+		Index:      166,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -800,8 +1690,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `S_OP : "-"	<<  >>`,
 		Id:         "S_OP",
-		NTType:     45,
-		Index:      78,
+		NTType:     88,
+		Index:      167,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -810,8 +1700,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `S_OP : empty	<<  >>`,
 		Id:         "S_OP",
-		NTType:     45,
-		Index:      79,
+		NTType:     88,
+		Index:      168,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
@@ -820,8 +1710,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `CTE : cte_int	<<  >>`,
 		Id:         "CTE",
-		NTType:     46,
-		Index:      80,
+		NTType:     89,
+		Index:      169,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -830,8 +1720,48 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `CTE : cte_float	<<  >>`,
 		Id:         "CTE",
-		NTType:     46,
-		Index:      81,
+		NTType:     89,
+		Index:      170,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CTE : "true"	<<  >>`,
+		Id:         "CTE",
+		NTType:     89,
+		Index:      171,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CTE : "false"	<<  >>`,
+		Id:         "CTE",
+		NTType:     89,
+		Index:      172,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CTE : cte_string	<<  >>`,
+		Id:         "CTE",
+		NTType:     89,
+		Index:      173,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CTE : cte_char	<<  >>`,
+		Id:         "CTE",
+		NTType:     89,
+		Index:      174,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -840,8 +1770,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `F_CALL : id CALL_ARGS_OPEN S_E ")"	<<  >>`,
 		Id:         "F_CALL",
-		NTType:     47,
-		Index:      82,
+		NTType:     90,
+		Index:      175,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -850,8 +1780,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `CALL_ARGS_OPEN : "("	<<  >>`,
 		Id:         "CALL_ARGS_OPEN",
-		NTType:     48,
-		Index:      83,
+		NTType:     91,
+		Index:      176,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -860,8 +1790,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `S_E : EXPRESSION R_E	<<  >>`,
 		Id:         "S_E",
-		NTType:     49,
-		Index:      84,
+		NTType:     92,
+		Index:      177,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -870,8 +1800,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `S_E : empty	<<  >>`,
 		Id:         "S_E",
-		NTType:     49,
-		Index:      85,
+		NTType:     92,
+		Index:      178,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
@@ -880,8 +1810,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `R_E : "," EXPRESSION R_E	<<  >>`,
 		Id:         "R_E",
-		NTType:     50,
-		Index:      86,
+		NTType:     93,
+		Index:      179,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -890,8 +1820,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `R_E : empty	<<  >>`,
 		Id:         "R_E",
-		NTType:     50,
-		Index:      87,
+		NTType:     93,
+		Index:      180,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
@@ -1,7 +1,9 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 
 	"Patito/semantic"
 	"Patito/token"
@@ -18,23 +20,54 @@ type functionCallInfo struct {
 	Args []functionArg
 }
 
+// functionSignature es `tipo nombre(params)`, compartido por prototipos y
+// definiciones de funciones.
+type functionSignature struct {
+	ReturnType semantic.Type
+	ID         *token.Token
+	Params     []*semantic.VariableSpec
+}
+
 func init() {
 	setReduceFuncByID("Program", 0, reduceProgram)
 	setReduceFuncByID("P_VAR", 0, passThrough)
-	setReduceFuncByID("P_VAR", 1, returnEmptySpecs)
+	setReduceFuncByID("GLOBAL_DECLS", 0, concatSpecSlices) // GLOBAL_DECLS : GLOBAL_DECL GLOBAL_DECLS
+	setReduceFuncByID("GLOBAL_DECLS", 1, returnEmptySpecs) // GLOBAL_DECLS : empty
+	setReduceFuncByID("GLOBAL_DECL", 0, passThrough)       // GLOBAL_DECL : DECL
+	setReduceFuncByID("GLOBAL_DECL", 1, returnEmptySpecs)  // GLOBAL_DECL : TYPE_DECL
+	setReduceFuncByID("TYPE_DECL", 0, reduceRecordType)    // TYPE_DECL : "type" id "=" "record" "{" FIELDS "}" ";"
+	setReduceFuncByID("FIELDS", 0, concatSpecSlices)       // FIELDS : FIELD FIELDS
+	setReduceFuncByID("FIELDS", 1, passThrough)            // FIELDS : FIELD
+	setReduceFuncByID("FIELD", 0, reduceFieldDeclaration)  // FIELD : id R_ID ":" TYPE ";"
+	setReduceFuncByID("DECLS", 0, concatSpecSlices)        // DECLS : DECL DECLS
+	setReduceFuncByID("DECLS", 1, returnEmptySpecs)        // DECLS : empty
+	setReduceFuncByID("DECL", 0, passThrough)              // DECL : VARS
+	setReduceFuncByID("DECL", 1, returnEmptySpecs)         // DECL : CONST_DECL
 	setReduceFuncByID("VARS", 0, takeSecond)
 	setReduceFuncByID("FVAR_LIST", 0, concatSpecSlices)
 	setReduceFuncByID("FVAR_LIST", 1, returnEmptySpecs)
 	setReduceFuncByID("F_VAR", 0, reduceVarDeclaration)
+	setReduceFuncByID("F_VAR", 1, reduceRecordVarDeclaration)  // F_VAR : id R_ID ":" id ";"
+	setReduceFuncByID("F_VAR", 2, reduceVarDeclarationError)   // F_VAR : SYNC ";"
+	setReduceFuncByID("CONST_HEAD", 0, reduceConstHead)        // CONST_HEAD : "const" id ":" TYPE
+	setReduceFuncByID("CONST_DECL", 0, reduceConstDeclaration) // CONST_DECL : CONST_HEAD "=" EXPRESSION ";"
 	setReduceFuncByID("F_CALL", 0, reduceFunctionCallStatement)
 	setReduceFuncByID("R_ID", 0, prependIDToken)
 	setReduceFuncByID("R_ID", 1, returnEmptyIDTokens)
+	setReduceFuncByID("DIMS", 0, reduceDims)      // DIMS : "[" cte_int "]"
+	setReduceFuncByID("DIMS", 1, reduceDims)      // DIMS : "[" cte_int "]" "[" cte_int "]"
+	setReduceFuncByID("DIMS", 2, returnNilAttrib) // DIMS : empty
 	setReduceFuncByID("TYPE", 0, reduceTypeInt)
 	setReduceFuncByID("TYPE", 1, reduceTypeFloat)
+	setReduceFuncByID("TYPE", 2, reduceTypeBool)
+	setReduceFuncByID("TYPE", 3, reduceTypeString)
+	setReduceFuncByID("TYPE", 4, reduceTypeChar)
 	setReduceFuncByID("F_T", 0, passThrough)
 	setReduceFuncByID("F_T", 1, reduceTypeVoid)
 	setReduceFuncByID("FUNCS", 0, reduceFunctionDefinition)
+	setReduceFuncByID("FUNCS", 1, reduceFunctionPrototype)
 	setReduceFuncByID("FUNC_HEADER", 0, reduceFunctionHeader)
+	setReduceFuncByID("FUNC_SIGNATURE", 0, reduceFunctionSignature)
 	setReduceFuncByID("FUNC_LOCALS", 0, takeSecond)
 	setReduceFuncByID("FUNC_LOCALS", 1, returnEmptySpecs)
 	setReduceFuncByID("S_T", 0, reduceParamSequence)
@@ -42,48 +75,94 @@ func init() {
 	setReduceFuncByID("R_T", 0, reduceParamTail)
 	setReduceFuncByID("R_T", 1, returnEmptySpecs)
 	setReduceFuncByID("S_V", 0, passThrough)
-	setReduceFuncByID("S_V", 1, returnEmptySpecs)
 	setReduceFuncByID("I_T", 0, reduceParam)
+	setReduceFuncByID("I_T", 1, reduceRefParam)
 	// Expresiones y estatutos
-	setReduceFuncByID("PRINT_P", 0, passThrough)            // PRINT_P : E_PRINT R_PRINT
-	setReduceFuncByID("E_PRINT", 0, reduceEPrintExpression) // E_PRINT : EXPRESSION
-	setReduceFuncByID("E_PRINT", 1, reduceEPrintString)     // E_PRINT : cte_string
-	setReduceFuncByID("R_PRINT", 0, reduceRPrint)           // R_PRINT : "," PRINT_P
-	setReduceFuncByID("R_PRINT", 1, returnEmptySpecs)       // R_PRINT : empty
-	setReduceFuncByID("ASSIGN", 0, reduceAssign)            // ASSIGN : id "=" EXPRESSION ";"
-	setReduceFuncByID("CYCLE", 0, reduceCycle)              // CYCLE : "while" "(" EXPRESSION ")" WHILE_MARK "do" BODY ";"
-	setReduceFuncByID("WHILE_MARK", 0, reduceWhileMark)     // WHILE_MARK : empty
-	setReduceFuncByID("CONDITION", 0, reduceCondition)      // CONDITION : "if" "(" EXPRESSION ")" IF_MARK BODY ";"
-	setReduceFuncByID("CONDITION", 1, reduceConditionElse)  // CONDITION : ...
-	setReduceFuncByID("IF_MARK", 0, reduceIfMark)           // IF_MARK : empty
-	setReduceFuncByID("ELSE_MARK", 0, reduceElseMark)       // ELSE_MARK : empty
-	setReduceFuncByID("RETURN", 0, reduceReturn)            // RETURN : "return" EXPRESSION ";"
-	setReduceFuncByID("RETURN", 1, reduceReturnVoid)        // RETURN : "return" ";"
-	setReduceFuncByID("EXPRESSION", 0, reduceExpression)    // EXPRESSION : EXP REL_TAIL
-	setReduceFuncByID("REL_TAIL", 0, reduceRelTail)         // REL_TAIL : REL_OP EXP
-	setReduceFuncByID("REL_TAIL", 1, returnEmptySpecs)      // REL_TAIL : empty
-	setReduceFuncByID("REL_OP", 0, reduceRelOpGt)           // REL_OP : ">"
-	setReduceFuncByID("REL_OP", 1, reduceRelOpLt)           // REL_OP : "<"
-	setReduceFuncByID("REL_OP", 2, reduceRelOpNeq)          // REL_OP : "!="
-	setReduceFuncByID("REL_OP", 3, reduceRelOpEq)           // REL_OP : "=="
-	setReduceFuncByID("EXP", 0, reduceExp)                  // EXP : TERMINO EXP_P
-	setReduceFuncByID("EXP_P", 0, reduceExpPAdd)            // EXP_P : "+" TERMINO EXP_P
-	setReduceFuncByID("EXP_P", 1, reduceExpPSub)            // EXP_P : "-" TERMINO EXP_P
-	setReduceFuncByID("EXP_P", 2, returnEmptySpecs)         // EXP_P : empty
+	setReduceFuncByID("STATEMENT", 7, reduceBreak)             // STATEMENT : "break" ";"
+	setReduceFuncByID("STATEMENT", 8, reduceContinue)          // STATEMENT : "continue" ";"
+	setReduceFuncByID("STATEMENT", 9, reduceBlock)             // STATEMENT : BRACKET_OPEN BLOCK_VARS P_STAT "]"
+	setReduceFuncByID("STATEMENT", 10, reduceStatementError)   // STATEMENT : SYNC ";"
+	setReduceFuncByID("BODY", 0, reduceBlock)                  // BODY : BLOCK_OPEN BLOCK_VARS P_STAT "}"
+	setReduceFuncByID("BLOCK_OPEN", 0, reduceBlockOpen)        // BLOCK_OPEN : "{"
+	setReduceFuncByID("BRACKET_OPEN", 0, reduceBlockOpen)      // BRACKET_OPEN : "["
+	setReduceFuncByID("READ_TARGET", 0, reduceReadTarget)      // READ_TARGET : id
+	setReduceFuncByID("READ_TARGET", 1, reduceReadTargetField) // READ_TARGET : id "." id
+	setReduceFuncByID("PRINT_P", 0, passThrough)               // PRINT_P : E_PRINT R_PRINT
+	setReduceFuncByID("E_PRINT", 0, reduceEPrintExpression)    // E_PRINT : EXPRESSION
+	setReduceFuncByID("R_PRINT", 0, reduceRPrint)              // R_PRINT : "," PRINT_P
+	setReduceFuncByID("R_PRINT", 1, returnEmptySpecs)          // R_PRINT : empty
+	setReduceFuncByID("ASSIGN", 0, reduceAssign)               // ASSIGN : id "=" EXPRESSION ";"
+	setReduceFuncByID("ASSIGN", 1, reduceAssignIndexed)        // ASSIGN : id INDICES "=" EXPRESSION ";"
+	setReduceFuncByID("ASSIGN", 2, reduceAssignField)          // ASSIGN : id "." id "=" EXPRESSION ";"
+	setReduceFuncByID("CYCLE", 0, reduceCycle)                 // CYCLE : WHILE_START "(" WHILE_COND ")" "do" BODY ";"
+	setReduceFuncByID("WHILE_START", 0, reduceWhileStart)      // WHILE_START : "while"
+	setReduceFuncByID("WHILE_COND", 0, reduceWhileCond)        // WHILE_COND : EXPRESSION
+	setReduceFuncByID("CYCLE", 1, reduceForLoop)               // CYCLE : FOR_HEAD "do" BODY ";"
+	setReduceFuncByID("CYCLE", 2, reduceDoWhile)               // CYCLE : DO_START BODY DO_WHILE "(" DO_COND ")" ";"
+	setReduceFuncByID("DO_START", 0, reduceDoStart)            // DO_START : "do"
+	setReduceFuncByID("DO_WHILE", 0, reduceDoWhileMark)        // DO_WHILE : "while"
+	setReduceFuncByID("DO_COND", 0, reduceDoCond)              // DO_COND : EXPRESSION
+	setReduceFuncByID("FOR_HEAD", 0, reduceForHead)            // FOR_HEAD : FOR_INIT "to" EXPRESSION FOR_STEP
+	setReduceFuncByID("FOR_INIT", 0, reduceForInit)            // FOR_INIT : "for" id "=" EXPRESSION
+	setReduceFuncByID("FOR_STEP", 0, reduceForStep)            // FOR_STEP : "step" EXPRESSION
+	setReduceFuncByID("FOR_STEP", 1, returnNilAttrib)          // FOR_STEP : empty
+	setReduceFuncByID("CONDITION", 0, returnNilAttrib)         // CONDITION : "if" "(" IF_COND ")" BODY ELSE_CHAIN ";"
+	setReduceFuncByID("ELSE_CHAIN", 0, reduceCondition)        // ELSE_CHAIN : empty
+	setReduceFuncByID("ELSE_CHAIN", 1, reduceConditionElse)    // ELSE_CHAIN : ELSE_MARK "else" BODY
+	setReduceFuncByID("ELSE_CHAIN", 2, reduceConditionElse)    // ELSE_CHAIN : ELSE_MARK "else" "if" "(" IF_COND ")" BODY ELSE_CHAIN
+	setReduceFuncByID("IF_COND", 0, reduceIfCond)              // IF_COND : EXPRESSION
+	setReduceFuncByID("ELSE_MARK", 0, reduceElseMark)          // ELSE_MARK : empty
+	setReduceFuncByID("SWITCH", 0, reduceSwitch)               // SWITCH : SWITCH_HEAD "{" CASES DEFAULT_CASE "}" ";"
+	setReduceFuncByID("SWITCH_HEAD", 0, reduceSwitchHead)      // SWITCH_HEAD : "switch" "(" EXPRESSION ")"
+	setReduceFuncByID("CASE_LABEL", 0, reduceCaseLabel)        // CASE_LABEL : "case" cte_int ":"
+	setReduceFuncByID("CASE_LABEL", 1, reduceCaseLabel)        // CASE_LABEL : "case" "-" cte_int ":"
+	setReduceFuncByID("DEFAULT_LABEL", 0, reduceDefault)       // DEFAULT_LABEL : "default" ":"
+	setReduceFuncByID("RETURN", 0, reduceReturn)               // RETURN : "return" EXPRESSION ";"
+	setReduceFuncByID("RETURN", 1, reduceReturnVoid)           // RETURN : "return" ";"
+	setReduceFuncByID("EXPRESSION", 0, reduceLogicalOr)        // EXPRESSION : EXPRESSION OR_MARK AND_EXP
+	setReduceFuncByID("EXPRESSION", 1, passThrough)            // EXPRESSION : AND_EXP
+	setReduceFuncByID("OR_MARK", 0, reduceOrMark)              // OR_MARK : "||"
+	setReduceFuncByID("AND_EXP", 0, reduceLogicalAnd)          // AND_EXP : AND_EXP AND_MARK REL_EXP
+	setReduceFuncByID("AND_EXP", 1, passThrough)               // AND_EXP : REL_EXP
+	setReduceFuncByID("AND_MARK", 0, reduceAndMark)            // AND_MARK : "&&"
+	setReduceFuncByID("REL_EXP", 0, reduceRelExp)              // REL_EXP : EXP REL_TAIL
+	setReduceFuncByID("REL_TAIL", 0, reduceRelTail)            // REL_TAIL : REL_OP EXP
+	setReduceFuncByID("REL_TAIL", 1, returnEmptySpecs)         // REL_TAIL : empty
+	setReduceFuncByID("REL_OP", 0, reduceRelOpGt)              // REL_OP : ">"
+	setReduceFuncByID("REL_OP", 1, reduceRelOpLt)              // REL_OP : "<"
+	setReduceFuncByID("REL_OP", 2, reduceRelOpNeq)             // REL_OP : "!="
+	setReduceFuncByID("REL_OP", 3, reduceRelOpEq)              // REL_OP : "=="
+	setReduceFuncByID("REL_OP", 4, reduceRelOpGte)             // REL_OP : ">="
+	setReduceFuncByID("REL_OP", 5, reduceRelOpLte)             // REL_OP : "<="
+	setReduceFuncByID("EXP", 0, reduceExp)                     // EXP : TERMINO EXP_P
+	setReduceFuncByID("EXP_P", 0, reduceExpPAdd)               // EXP_P : "+" TERMINO EXP_P
+	setReduceFuncByID("EXP_P", 1, reduceExpPSub)               // EXP_P : "-" TERMINO EXP_P
+	setReduceFuncByID("EXP_P", 2, returnEmptySpecs)            // EXP_P : empty
 	setReduceFuncByID("ADD_MARK", 0, reduceAddMark)
 	setReduceFuncByID("SUB_MARK", 0, reduceSubMark)
 	setReduceFuncByID("TERMINO", 0, reduceTermino)       // TERMINO : FACTOR TERMINO_P
 	setReduceFuncByID("TERMINO_P", 0, reduceTerminoPMul) // TERMINO_P : "*" FACTOR TERMINO_P
 	setReduceFuncByID("TERMINO_P", 1, reduceTerminoPDiv) // TERMINO_P : "/" FACTOR TERMINO_P
-	setReduceFuncByID("TERMINO_P", 2, returnEmptySpecs)  // TERMINO_P : empty
+	setReduceFuncByID("TERMINO_P", 2, reduceTerminoPMod) // TERMINO_P : "%" FACTOR TERMINO_P
+	setReduceFuncByID("TERMINO_P", 3, returnEmptySpecs)  // TERMINO_P : empty
 	setReduceFuncByID("MUL_MARK", 0, reduceMulMark)
 	setReduceFuncByID("DIV_MARK", 0, reduceDivMark)
-	setReduceFuncByID("FACTOR", 0, reduceFactor) // FACTOR : S_OP FACTOR_CORE
+	setReduceFuncByID("MOD_MARK", 0, reduceModMark)
+	setReduceFuncByID("FACTOR", 0, reduceFactor)    // FACTOR : S_OP FACTOR_CORE
+	setReduceFuncByID("FACTOR", 1, reduceFactorNot) // FACTOR : "!" FACTOR
 	setReduceFuncByID("FACTOR_CORE", 0, reduceFactorCoreParen)
+	setReduceFuncByID("PAREN_OPEN", 0, reduceParenOpen)
 	setReduceFuncByID("FACTOR_CORE", 1, reduceFactorCoreId)
 	setReduceFuncByID("FACTOR_CORE", 2, reduceFactorCoreCte)
+	setReduceFuncByID("FACTOR_CORE", 3, reduceFactorCoreBuiltin) // FACTOR_CORE : BUILTIN PAREN_OPEN EXPRESSION ")"
 	setReduceFuncByID("FACTOR_SUFFIX", 0, reduceFactorSuffixCall)
-	setReduceFuncByID("FACTOR_SUFFIX", 1, returnNilAttrib)
+	setReduceFuncByID("FACTOR_SUFFIX", 1, passThrough) // FACTOR_SUFFIX : INDICES
+	setReduceFuncByID("FACTOR_SUFFIX", 2, takeSecond)  // FACTOR_SUFFIX : "." id
+	setReduceFuncByID("FACTOR_SUFFIX", 3, returnNilAttrib)
+	setReduceFuncByID("INDICES", 0, reduceIndices) // INDICES : INDEX
+	setReduceFuncByID("INDICES", 1, reduceIndices) // INDICES : INDEX INDEX
+	setReduceFuncByID("INDEX", 0, reduceIndex)     // INDEX : INDEX_OPEN EXPRESSION "]"
+	setReduceFuncByID("INDEX_OPEN", 0, reduceIndexOpen)
 	setReduceFuncByID("CALL_ARGS_OPEN", 0, reduceCallArgsOpen)
 	setReduceFuncByID("S_E", 0, reduceSEWithExpression)
 	setReduceFuncByID("S_E", 1, returnEmptyArgs)
@@ -131,6 +210,15 @@ func tokenFromAttrib(a Attrib) (*token.Token, error) {
 	return tok, nil
 }
 
+// exprPos regresa la posición del primer token de una expresión; las
+// reducciones de expresiones propagan ese token como atributo.
+func exprPos(a Attrib) token.Pos {
+	if tok, ok := a.(*token.Token); ok && tok != nil {
+		return tok.Pos
+	}
+	return token.Pos{}
+}
+
 func specsFromAttrib(a Attrib) []*semantic.VariableSpec {
 	if a == nil {
 		return nil
@@ -181,15 +269,11 @@ func reduceProgram(X []Attrib, C interface{}) (Attrib, error) {
 		return nil, err
 	}
 	if err := ctx.Directory.SetProgram(programID.IDValue(), programID.Pos); err != nil {
-		return nil, err
+		ctx.Report(err)
 	}
 	if globals := specsFromAttrib(X[3]); len(globals) > 0 {
 		if err := ctx.Directory.AddGlobals(globals, ctx.AddressManager); err != nil {
-			return nil, err
-		}
-		// Almacenar direcciones en VariableAddresses para uso inmediato
-		for _, spec := range globals {
-			ctx.VariableAddresses[spec.Name] = spec.Address
+			ctx.Report(err)
 		}
 	}
 
@@ -238,6 +322,15 @@ func reduceProgram(X []Attrib, C interface{}) (Attrib, error) {
 		}
 	}
 
+	// Las sobrecargas se conocen hasta aquí: sus llamadas usan la firma
+	semantic.ResolveFunctionSymbols(ctx)
+
+	// Los prototipos sin definición no tienen cuádruplo de inicio
+	for _, fn := range ctx.Directory.UndefinedFunctions() {
+		ctx.Report(semantic.NewDiagnostic(semantic.CodeUndefinedFunction, fn.DeclaredAt, len(fn.Name),
+			"la función '%s' se declaró con un prototipo pero nunca se definió", fn.Symbol))
+	}
+
 	// Generate END at the end of main body
 	semantic.ProcessMainEnd(ctx)
 
@@ -303,33 +396,249 @@ func reduceVarDeclaration(X []Attrib, C interface{}) (Attrib, error) {
 	if !ok {
 		return nil, fmt.Errorf("esperaba semantic.Type, obtuvo %T", X[3])
 	}
+	dims := arrayDims(ctx, idTokensFromAttrib(X[4]))
 
 	idTokens := append([]*token.Token{firstID}, otherIDs...)
+	return declareVariables(ctx, idTokens, typeVal, dims, nil), nil
+}
+
+// reduceRecordVarDeclaration: F_VAR -> id R_ID ":" id ";"
+// Cada variable reserva un bloque con una celda por campo del record.
+func reduceRecordVarDeclaration(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	firstID, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	typeTok, err := tokenFromAttrib(X[3])
+	if err != nil {
+		return nil, err
+	}
+	idTokens := append([]*token.Token{firstID}, idTokensFromAttrib(X[1])...)
+
+	record, ok := ctx.Directory.GetRecord(typeTok.IDValue())
+	if !ok {
+		// Se declaran como inválidas para no reportar cada uso
+		ctx.Report(semantic.DiagnosticAt(semantic.CodeUndeclaredType, typeTok, "tipo '%s' no declarado", typeTok.IDValue()))
+		return declareVariables(ctx, idTokens, semantic.TypeInvalid, nil, nil), nil
+	}
+	return declareVariables(ctx, idTokens, semantic.TypeRecord, nil, record), nil
+}
+
+// declareVariables registra las variables en el contexto y les reserva
+// direcciones globales o locales según dónde se declaren.
+func declareVariables(ctx *semantic.Context, idTokens []*token.Token, typeVal semantic.Type, dims []int, record *semantic.RecordType) []*semantic.VariableSpec {
 	specs := make([]*semantic.VariableSpec, 0, len(idTokens))
+	scope := ctx.CurrentScope().Kind
 	for _, tok := range idTokens {
 		varName := tok.IDValue()
-		// Almacenar tipo en contexto para uso inmediato durante parsing
-		ctx.VariableTypes[varName] = typeVal
-		// fmt.Printf("Tenemos variable %v", varName)
-		// fmt.Printf("\n")
-		// reduceProgram agregue las variables al directorio
+		conflict := semantic.CheckConstantConflict(ctx, varName, tok.Pos)
+		// Los arreglos y records reservan un bloque contiguo con una celda por
+		// elemento o campo; las variables de bloque usan el segmento local,
+		// también dentro de main
+		segment, available := semantic.SegmentLocal, ctx.AddressManager.LocalAvailable()
+		if scope == semantic.ScopeGlobal {
+			segment, available = semantic.SegmentGlobal, ctx.AddressManager.GlobalAvailable()
+		}
+		fits := semantic.ArrayFits(dims, available)
+		size := 0
+		if fits {
+			size = semantic.ArraySize(dims)
+		}
+		if record != nil {
+			fits, size = record.Size <= available, record.Size
+		}
+		if !fits {
+			// Sin reservar nada: el programa ya no compila y así no se desborda el segmento
+			ctx.Report(semantic.DiagnosticAt(semantic.CodeArraySize, tok,
+				"'%s' no cabe en el segmento %s: quedan %d direcciones", varName, segment, available))
+			size = 0
+		}
 		var addr int
-		if ctx.CurrentFunction != nil {
-			addr = ctx.AddressManager.NextLocal()
+		var err error
+		if scope == semantic.ScopeGlobal {
+			addr, err = ctx.AddressManager.NextGlobalBlock(size)
 		} else {
-			addr = ctx.AddressManager.NextGlobal()
+			addr, err = ctx.AddressManager.NextLocalBlock(size)
 		}
-		ctx.VariableAddresses[varName] = addr
-		// fmt.Printf("Tenemos direccion %d", addr)
-		// fmt.Printf("\n")
-		specs = append(specs, &semantic.VariableSpec{
+		ctx.Report(err)
+		spec := &semantic.VariableSpec{
 			Name:    varName,
 			Type:    typeVal,
 			Pos:     tok.Pos,
 			Address: addr,
-		})
+			Dims:    dims,
+			Record:  record,
+		}
+		// Visible desde ya en el scope actual. Las globales y locales de la
+		// función se registran en el directorio (que reporta sus duplicados)
+		// al terminar; las de un bloque sólo existen en su scope.
+		if err := ctx.Declare(spec); err != nil && scope == semantic.ScopeBlock && !conflict {
+			ctx.Report(err)
+		}
+		if scope == semantic.ScopeBlock {
+			semantic.ProcessBlockVariable(ctx, spec)
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// reduceDims: DIMS -> "[" cte_int "]" | "[" cte_int "]" "[" cte_int "]"
+// Regresa los tokens con el tamaño de cada dimensión.
+func reduceDims(X []Attrib, _ interface{}) (Attrib, error) {
+	sizes := make([]*token.Token, 0, 2)
+	for i := 1; i < len(X); i += 3 {
+		tok, err := tokenFromAttrib(X[i])
+		if err != nil {
+			return nil, err
+		}
+		sizes = append(sizes, tok)
+	}
+	return sizes, nil
+}
+
+// arrayDims convierte los tamaños declarados en DIMS. Un tamaño inválido se
+// reporta y se toma como 1 para seguir asignando direcciones.
+func arrayDims(ctx *semantic.Context, sizes []*token.Token) []int {
+	if len(sizes) == 0 {
+		return nil
+	}
+	dims := make([]int, len(sizes))
+	for i, tok := range sizes {
+		n, err := strconv.Atoi(string(tok.Lit))
+		if errors.Is(err, strconv.ErrRange) {
+			ctx.Report(semantic.DiagnosticAt(semantic.CodeArraySize, tok,
+				"el tamaño de arreglo %s es demasiado grande", tok.Lit))
+			n = 1
+		} else if err != nil || n <= 0 {
+			ctx.Report(semantic.DiagnosticAt(semantic.CodeArraySize, tok,
+				"el tamaño de un arreglo debe ser un entero positivo, se obtuvo %s", tok.Lit))
+			n = 1
+		}
+		dims[i] = n
+	}
+	return dims
+}
+
+// reduceRecordType: TYPE_DECL -> "type" id "=" "record" "{" FIELDS "}" ";"
+func reduceRecordType(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	idTok, err := tokenFromAttrib(X[1])
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ctx.Directory.AddRecord(idTok.IDValue(), idTok.Pos, specsFromAttrib(X[5])); err != nil {
+		ctx.Report(err)
+	}
+	return nil, nil
+}
+
+// reduceFieldDeclaration: FIELD -> id R_ID ":" TYPE ";"
+func reduceFieldDeclaration(X []Attrib, _ interface{}) (Attrib, error) {
+	firstID, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	typeVal, ok := X[3].(semantic.Type)
+	if !ok {
+		return nil, fmt.Errorf("esperaba semantic.Type, obtuvo %T", X[3])
+	}
+	idTokens := append([]*token.Token{firstID}, idTokensFromAttrib(X[1])...)
+	fields := make([]*semantic.VariableSpec, 0, len(idTokens))
+	for _, tok := range idTokens {
+		fields = append(fields, &semantic.VariableSpec{Name: tok.IDValue(), Type: typeVal, Pos: tok.Pos})
+	}
+	return fields, nil
+}
+
+// reduceConstHead: CONST_HEAD -> "const" id ":" TYPE
+func reduceConstHead(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	idTok, err := tokenFromAttrib(X[1])
+	if err != nil {
+		return nil, err
+	}
+	typeVal, ok := X[3].(semantic.Type)
+	if !ok {
+		return nil, fmt.Errorf("esperaba semantic.Type, obtuvo %T", X[3])
+	}
+	return semantic.ProcessConstStart(ctx, idTok.IDValue(), typeVal, idTok.Pos), nil
+}
+
+// reduceConstDeclaration: CONST_DECL -> CONST_HEAD "=" EXPRESSION ";"
+// El valor se calcula en compilación y la constante queda en la tabla de
+// constantes; no se reserva una dirección de variable.
+func reduceConstDeclaration(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	decl, ok := X[0].(*semantic.ConstDeclaration)
+	if !ok {
+		return nil, fmt.Errorf("esperaba *semantic.ConstDeclaration, obtuvo %T", X[0])
+	}
+	if err := semantic.ProcessConstValue(ctx, decl, exprPos(X[2])); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// reduceVarDeclarationError: F_VAR -> SYNC ";"
+func reduceVarDeclarationError(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	reportSyntaxError(ctx, X[0])
+	// Un error dentro del valor de una constante deja la expresión a medias
+	ctx.ResetExpressionStacks()
+	return []*semantic.VariableSpec{}, nil
+}
+
+// reduceBlockOpen: BLOCK_OPEN -> "{" | BRACKET_OPEN -> "["
+func reduceBlockOpen(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	ctx.PushScope(semantic.ScopeBlock)
+	return X[0], nil
+}
+
+// reduceBlock: BODY -> BLOCK_OPEN BLOCK_VARS P_STAT "}" y
+// STATEMENT -> BRACKET_OPEN BLOCK_VARS P_STAT "]"
+// Al cerrar el bloque sus variables dejan de ser visibles.
+func reduceBlock(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.PopScope(); err != nil {
+		return nil, err
+	}
+	return X[0], nil
+}
+
+// reduceStatementError: STATEMENT -> SYNC ";"
+func reduceStatementError(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
 	}
-	return specs, nil
+	reportSyntaxError(ctx, X[0])
+	// La expresión interrumpida pudo dejar operandos y operadores a medias
+	ctx.ResetExpressionStacks()
+	return nil, nil
 }
 
 func prependIDToken(X []Attrib, _ interface{}) (Attrib, error) {
@@ -352,45 +661,108 @@ func reduceTypeFloat(_ []Attrib, _ interface{}) (Attrib, error) {
 	return semantic.TypeFloat, nil
 }
 
+func reduceTypeBool(_ []Attrib, _ interface{}) (Attrib, error) {
+	return semantic.TypeBool, nil
+}
+
+func reduceTypeString(_ []Attrib, _ interface{}) (Attrib, error) {
+	return semantic.TypeString, nil
+}
+
+func reduceTypeChar(_ []Attrib, _ interface{}) (Attrib, error) {
+	return semantic.TypeChar, nil
+}
+
 func reduceTypeVoid(_ []Attrib, _ interface{}) (Attrib, error) {
 	return semantic.TypeVoid, nil
 }
 
-func reduceFunctionHeader(X []Attrib, C interface{}) (Attrib, error) {
-	ctx, err := semanticCtx(C)
+// reduceFunctionSignature: FUNC_SIGNATURE -> F_T id "(" S_T ")"
+func reduceFunctionSignature(X []Attrib, _ interface{}) (Attrib, error) {
+	returnType, ok := X[0].(semantic.Type)
+	if !ok {
+		return nil, fmt.Errorf("esperaba semanticType para retorno, obtuvo %T", X[0])
+	}
+
+	fnID, err := tokenFromAttrib(X[1])
 	if err != nil {
 		return nil, err
 	}
 
-	returnType, ok := X[0].(semantic.Type)
+	return &functionSignature{ReturnType: returnType, ID: fnID, Params: specsFromAttrib(X[3])}, nil
+}
+
+// reduceFunctionPrototype: FUNCS -> FUNC_SIGNATURE ";"
+// Declara la función para poder llamarla antes de su definición (p. ej. en
+// funciones mutuamente recursivas).
+func reduceFunctionPrototype(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	sig, ok := X[0].(*functionSignature)
 	if !ok {
-		return nil, fmt.Errorf("esperaba semanticType para retorno, obtuvo %T", X[0])
+		return nil, fmt.Errorf("esperaba *functionSignature y recibió %T", X[0])
 	}
+	if _, err := ctx.Directory.DeclareFunction(sig.ID.IDValue(), sig.ReturnType, sig.ID.Pos, sig.Params, ctx.AddressManager); err != nil {
+		ctx.Report(err)
+	}
+	return nil, nil
+}
 
-	fnID, err := tokenFromAttrib(X[1])
+// reduceFunctionHeader: FUNC_HEADER -> FUNC_SIGNATURE
+func reduceFunctionHeader(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
 
+	sig, ok := X[0].(*functionSignature)
+	if !ok {
+		return nil, fmt.Errorf("esperaba *functionSignature y recibió %T", X[0])
+	}
+	returnType := sig.ReturnType
+	fnID := sig.ID
 	fnName := fnID.IDValue()
-	params := specsFromAttrib(X[3])
+	params := sig.Params
 
-	fnEntry, err := ctx.Directory.AddFunctionPrototype(fnName, returnType, fnID.Pos, params, ctx.AddressManager)
+	fnEntry, err := ctx.Directory.DefineFunction(fnName, returnType, fnID.Pos, params, ctx.AddressManager)
 	if err != nil {
-		return err, nil
+		// Seguir analizando el cuerpo con una entrada fuera del directorio
+		ctx.Report(err)
+		fnEntry = detachedFunction(fnName, returnType, fnID.Pos, params)
 	}
 
 	ctx.CurrentFunction = fnEntry
-	semantic.ProcessFunctionStart(ctx, fnName)
+	semantic.ProcessFunctionStart(ctx, fnEntry)
 
+	// Los parámetros y las locales de `[ ]` comparten el scope de la función;
+	// sus duplicados los reporta el directorio. Un parámetro oculta a la
+	// variable o constante global con su nombre.
+	ctx.PushScope(semantic.ScopeLocal)
 	for _, spec := range params {
-		ctx.VariableAddresses[spec.Name] = spec.Address
-		ctx.VariableTypes[spec.Name] = spec.Type
+		_ = ctx.Declare(spec)
 	}
 
 	return fnEntry, nil
 }
 
+// detachedFunction crea una entrada que no se registra en el directorio para
+// analizar el cuerpo de una función cuya declaración tuvo errores.
+func detachedFunction(name string, returnType semantic.Type, pos token.Pos, params []*semantic.VariableSpec) *semantic.FunctionEntry {
+	paramTable := semantic.NewVariableTable(semantic.ScopeParam)
+	for _, spec := range params {
+		_ = paramTable.Add(spec) // los duplicados ya se reportaron
+	}
+	return &semantic.FunctionEntry{
+		Name:       name,
+		ReturnType: returnType,
+		DeclaredAt: pos,
+		Params:     paramTable,
+		Locals:     semantic.NewVariableTable(semantic.ScopeLocal),
+	}
+}
+
 func reduceFunctionDefinition(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
@@ -404,25 +776,21 @@ func reduceFunctionDefinition(X []Attrib, C interface{}) (Attrib, error) {
 
 	locals := specsFromAttrib(X[1])
 	if err := ctx.Directory.FinalizeFunction(fnEntry, locals, ctx.AddressManager); err != nil {
-		return nil, err
-	}
-
-	for _, spec := range locals {
-		ctx.VariableAddresses[spec.Name] = spec.Address
-		ctx.VariableTypes[spec.Name] = spec.Type
+		ctx.Report(err)
 	}
 
-	if err := validateFunctionReturns(ctx, fnEntry); err != nil {
-		return nil, err
-	}
+	validateFunctionReturns(ctx, fnEntry)
 
 	semantic.ProcessFunctionEnd(ctx)
 	ctx.CurrentFunction = nil
+	if err := ctx.PopScope(); err != nil {
+		return nil, err
+	}
 	return nil, nil
 
 }
 
-func validateFunctionReturns(ctx *semantic.Context, fn *semantic.FunctionEntry) error {
+func validateFunctionReturns(ctx *semantic.Context, fn *semantic.FunctionEntry) {
 	hadReturn := false
 	filtered := ctx.PendingReturns[:0]
 
@@ -433,23 +801,20 @@ func validateFunctionReturns(ctx *semantic.Context, fn *semantic.FunctionEntry)
 		}
 
 		hadReturn = true
-		if pending.Type != fn.ReturnType {
-			return fmt.Errorf("%s: tipo de retorno %s no coincide con tipo de funcion %s", pending.Pos, pending.Type, fn.ReturnType)
+		if pending.Type != fn.ReturnType && pending.Type != semantic.TypeInvalid {
+			ctx.Report(semantic.NewDiagnostic(semantic.CodeReturnType, pending.Pos, len("return"),
+				"tipo de retorno %s no coincide con tipo de funcion %s", pending.Type, fn.ReturnType))
 		}
 	}
 
 	ctx.PendingReturns = filtered
 	if !hadReturn {
-		return fmt.Errorf("error: funcion %s debe tener al menos un return statement", fn.Name)
+		ctx.Report(semantic.NewDiagnostic(semantic.CodeMissingReturn, fn.DeclaredAt, len(fn.Name),
+			"funcion %s debe tener al menos un return statement", fn.Name))
 	}
-	return nil
 }
 
-func reduceParamSequence(X []Attrib, C interface{}) (Attrib, error) {
-	ctx, err := semanticCtx(C)
-	if err != nil {
-		return nil, err
-	}
+func reduceParamSequence(X []Attrib, _ interface{}) (Attrib, error) {
 	first, ok := X[0].(*semantic.VariableSpec)
 	if !ok || first == nil {
 		return nil, fmt.Errorf("esperaba VariableSpec, obtuvo %T", X[0])
@@ -459,10 +824,9 @@ func reduceParamSequence(X []Attrib, C interface{}) (Attrib, error) {
 	out = append(out, first)
 	out = append(out, rest...)
 
-	// Add parameters to context so they are available when body is parsed
+	// Las direcciones se asignan al registrar la función
 	for _, spec := range out {
-		ctx.VariableTypes[spec.Name] = spec.Type
-		spec.Address = 0 // will be assigned in reduceFunctionHeader
+		spec.Address = 0
 	}
 
 	return out, nil
@@ -496,6 +860,17 @@ func reduceParam(X []Attrib, _ interface{}) (Attrib, error) {
 	}, nil
 }
 
+// reduceRefParam: I_T -> "ref" id ":" TYPE
+func reduceRefParam(X []Attrib, C interface{}) (Attrib, error) {
+	param, err := reduceParam(X[1:], C)
+	if err != nil {
+		return nil, err
+	}
+	spec := param.(*semantic.VariableSpec)
+	spec.ByRef = true
+	return spec, nil
+}
+
 func reduceFunctionCallStatement(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
@@ -507,11 +882,13 @@ func reduceFunctionCallStatement(X []Attrib, C interface{}) (Attrib, error) {
 	}
 	args := functionArgsFromAttrib(X[2])
 	callInfo := &functionCallInfo{Args: args}
-	if _, err := processFunctionCall(ctx, fnID, callInfo); err != nil {
+	fnEntry, err := processFunctionCall(ctx, fnID, callInfo)
+	if err != nil {
 		return nil, err
 	}
-	// Discard return value if present (calls as statements ignore it)
-	if fnEntry, ok := ctx.Directory.GetFunction(fnID.IDValue()); ok && fnEntry.ReturnType != semantic.TypeVoid {
+	// Discard return value if present (calls as statements ignore it).
+	// Unresolved calls also leave a placeholder operand on the stack.
+	if fnEntry == nil || fnEntry.ReturnType != semantic.TypeVoid {
 		ctx.OperandStack.Pop()
 		ctx.TypeStack.Pop()
 	}
@@ -553,7 +930,24 @@ func reduceFactorCoreId(X []Attrib, C interface{}) (Attrib, error) {
 
 	// Check if FACTOR_SUFFIX is a function call (not empty)
 	if callInfo, ok := X[1].(*functionCallInfo); ok {
-		return processFunctionCall(ctx, idTok, callInfo)
+		if _, err := processFunctionCall(ctx, idTok, callInfo); err != nil {
+			return nil, err
+		}
+		return idTok, nil
+	}
+
+	// ... a record field (FACTOR_SUFFIX : "." id) ...
+	if fieldTok, ok := X[1].(*token.Token); ok {
+		semantic.ProcessFieldAccess(ctx, idTok.IDValue(), idTok.Pos, fieldTok.IDValue(), fieldTok.Pos)
+		return idTok, nil
+	}
+
+	// ... or an array access; the indices are already on the operand stack
+	if indexPos, ok := X[1].([]token.Pos); ok {
+		if err := semantic.ProcessArrayAccess(ctx, idTok.IDValue(), idTok.Pos, indexPos); err != nil {
+			return nil, err
+		}
+		return idTok, nil
 	}
 
 	if err := semantic.PushVariable(ctx, idTok.IDValue(), idTok.Pos); err != nil {
@@ -562,83 +956,121 @@ func reduceFactorCoreId(X []Attrib, C interface{}) (Attrib, error) {
 	return idTok, nil
 }
 
-func processFunctionCall(ctx *semantic.Context, fnID *token.Token, callInfo *functionCallInfo) (Attrib, error) {
+// processFunctionCall resolves the called overload from the argument types
+// and generates ERA, PARAM/PARAMREF and GOSUB. It returns nil (after
+// reporting) when no function can be chosen; a placeholder operand is then
+// left on the stack in place of the result.
+func processFunctionCall(ctx *semantic.Context, fnID *token.Token, callInfo *functionCallInfo) (*semantic.FunctionEntry, error) {
 	fnName := fnID.IDValue()
 
-	//Get the function from the directory
-	fnEntry, ok := ctx.Directory.GetFunction(fnName)
-	if !ok {
-		return nil, fmt.Errorf("%s: función '%s' no declarada", fnID.Pos, fnName)
+	argValues := make([]string, 0, len(callInfo.Args))
+	argTypes := make([]semantic.Type, 0, len(callInfo.Args))
+	for _, arg := range callInfo.Args {
+		argValues = append(argValues, arg.Value)
+		argTypes = append(argTypes, arg.Type)
 	}
 
-	expectedParamCount := len(fnEntry.Params.Entries())
-
-	// Get arguments from operand stack or provided call info
-	argValues := make([]string, 0, expectedParamCount)
-	argTypes := make([]semantic.Type, 0, expectedParamCount)
+	fnEntry := semantic.ResolveCall(ctx, fnName, fnID.Pos, argTypes)
+	if fnEntry == nil {
+		semantic.PushOperand(ctx, fnName, semantic.TypeInvalid)
+		return nil, nil
+	}
 
-	if callInfo != nil {
-		for _, arg := range callInfo.Args {
-			argValues = append(argValues, arg.Value)
-			argTypes = append(argTypes, arg.Type)
-		}
+	// Validate the argument count, then argument types
+	params := fnEntry.Params.Entries()
+	if len(argValues) != len(params) {
+		ctx.Report(semantic.DiagnosticAt(semantic.CodeArgumentCount, fnID, "función '%s' esperaba %d argumentos, pero se proporcionaron %d",
+			fnName, len(params), len(argValues)))
 	} else {
-		for i := 0; i < expectedParamCount; i++ {
-			if ctx.OperandStack.IsEmpty() {
-				return nil, fmt.Errorf("%s: función '%s' esperaba %d argumentos, pero se proporcionaron menos", fnID.Pos, fnName, expectedParamCount)
+		for i, param := range params {
+			if _, ok := semantic.AcceptsArgument(ctx, param, argTypes[i]); !ok {
+				ctx.Report(semantic.DiagnosticAt(semantic.CodeArgumentType, fnID, "tipo de argumento %d en llamada a '%s': esperaba %s, obtuvo %s", i+1, fnName, param.Type, argTypes[i]))
+			} else if param.ByRef && argTypes[i] != semantic.TypeInvalid && !semantic.IsAssignableOperand(argValues[i]) {
+				ctx.Report(semantic.DiagnosticAt(semantic.CodeReferenceArgument, fnID, "argumento %d en llamada a '%s': el parámetro ref '%s' requiere una variable", i+1, fnName, param.Name))
 			}
-			argValue, _ := ctx.OperandStack.Pop()
-			argType, _ := ctx.TypeStack.Pop()
-			argValues = append([]string{argValue}, argValues...)     // Prepend to maintain the order
-			argTypes = append([]semantic.Type{argType}, argTypes...) // Prepend to maintain the order
 		}
 	}
 
-	// Validate the argument count
-	if len(argValues) != expectedParamCount {
-		return nil, fmt.Errorf("%s: función '%s' esperaba %d argumentos, pero se proporcionaron %d",
-			fnID.Pos, fnName, expectedParamCount, len(argValues))
+	// El valor de una constante no genera la llamada
+	if semantic.FoldCall(ctx, fnEntry.ReturnType) {
+		return fnEntry, nil
 	}
 
-	// Validate argument types
-	params := fnEntry.Params.Entries()
-	for i, param := range params {
-		if argTypes[i] != param.Type {
-			return nil, fmt.Errorf("%s: tipo de argumento %d en llama a '%s': esperaba %s, obtuvo %s", fnID.Pos, i+1, fnName, param.Type, argTypes[i])
-		}
-	}
-
-	semantic.GenerateQuadruple(ctx, "ERA", fnName, "", "")
+	semantic.GenerateCallQuadruple(ctx, "ERA", fnEntry, "")
 
-	// Generate PARAM quadruples for each argument
-	for _, argValue := range argValues {
-		semantic.GenerateQuadruple(ctx, "PARAM", argValue, "", "")
+	// Generate PARAM quadruples for each argument; ref parameters receive
+	// the caller's address with PARAMREF
+	for i, argValue := range argValues {
+		operator := "PARAM"
+		if i < len(params) && params[i].ByRef {
+			operator = "PARAMREF"
+		}
+		semantic.GenerateQuadruple(ctx, operator, argValue, "", "")
 	}
 
 	// For non-void functions, create a temp to store the return value
 	// This temp address is passed to GOSUB so RETURN knows where to store the value
 	var resultTemp string
 	if fnEntry.ReturnType != semantic.TypeVoid {
-		resultTemp = ctx.TempCounter.NextString()
+		resultTemp = ctx.NewTemp()
 	}
 
 	// Generate GOSUB with result address (empty for void functions)
-	semantic.GenerateQuadruple(ctx, "GOSUB", fnName, "", resultTemp)
+	semantic.GenerateCallQuadruple(ctx, "GOSUB", fnEntry, resultTemp)
 
 	if fnEntry.ReturnType != semantic.TypeVoid {
 		// Push the return value location onto the operand stack
 		semantic.PushOperand(ctx, resultTemp, fnEntry.ReturnType)
 	}
 
-	return fnID, nil
+	return fnEntry, nil
 }
 
-// reduceFactorCoreParen: FACTOR_CORE -> "(" EXPRESSION ")"
+// reduceFactorCoreParen: FACTOR_CORE -> PAREN_OPEN EXPRESSION ")"
 func reduceFactorCoreParen(X []Attrib, C interface{}) (Attrib, error) {
-	// La expresión ya procesó todo, solo pasamos
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	// La expresión ya procesó todo; sólo retiramos el fondo falso
+	op, ok := ctx.OpStack.Pop()
+	if !ok || op != "(" {
+		return nil, fmt.Errorf("error interno: faltaba fondo falso de paréntesis, se encontró %q", op)
+	}
 	return X[1], nil
 }
 
+// reduceFactorCoreBuiltin: FACTOR_CORE -> BUILTIN PAREN_OPEN EXPRESSION ")"
+func reduceFactorCoreBuiltin(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	op, ok := ctx.OpStack.Pop()
+	if !ok || op != "(" {
+		return nil, fmt.Errorf("error interno: faltaba fondo falso de paréntesis, se encontró %q", op)
+	}
+	nameTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessBuiltin(ctx, string(nameTok.Lit), exprPos(X[2])); err != nil {
+		return nil, err
+	}
+	return nameTok, nil
+}
+
+// reduceParenOpen: PAREN_OPEN -> "("
+// Apila un fondo falso para que los operadores de fuera no se resuelvan dentro.
+func reduceParenOpen(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	ctx.OpStack.Push("(")
+	return X[0], nil
+}
+
 // reduceFactor: FACTOR -> S_OP FACTOR_CORE
 func reduceFactor(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
@@ -655,7 +1087,7 @@ func reduceFactor(X []Attrib, C interface{}) (Attrib, error) {
 			} else if op == "-" {
 				// - unario: procesar después de que FACTOR_CORE haya apilado el operando
 				// El operando ya está en la pila, solo aplicar el operador unario
-				if err := semantic.ProcessUnaryOperator(ctx, "u-"); err != nil {
+				if err := semantic.ProcessUnaryOperator(ctx, "u-", opTok.Pos); err != nil {
 					return nil, err
 				}
 			}
@@ -665,6 +1097,22 @@ func reduceFactor(X []Attrib, C interface{}) (Attrib, error) {
 	return X[1], nil
 }
 
+// reduceFactorNot: FACTOR -> "!" FACTOR
+func reduceFactorNot(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessUnaryOperator(ctx, "!", opTok.Pos); err != nil {
+		return nil, err
+	}
+	return opTok, nil
+}
+
 // reduceTermino: TERMINO -> FACTOR TERMINO_P
 func reduceTermino(X []Attrib, C interface{}) (Attrib, error) {
 	// Solo pasamos, TERMINO_P ya procesó los operadores
@@ -681,39 +1129,68 @@ func reduceTerminoPDiv(X []Attrib, C interface{}) (Attrib, error) {
 	return X[2], nil
 }
 
+// reduceTerminoPMod: TERMINO_P -> "%" FACTOR TERMINO_P
+func reduceTerminoPMod(X []Attrib, C interface{}) (Attrib, error) {
+	return X[2], nil
+}
+
 // reduceMulMark: MUL_MARK -> "*"
-func reduceMulMark(_ []Attrib, C interface{}) (Attrib, error) {
+func reduceMulMark(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
-	if err := semantic.ProcessOperator(ctx, "*"); err != nil {
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessOperator(ctx, "*", opTok.Pos); err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
 
 // reduceDivMark: DIV_MARK -> "/"
-func reduceDivMark(_ []Attrib, C interface{}) (Attrib, error) {
+func reduceDivMark(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
-	if err := semantic.ProcessOperator(ctx, "/"); err != nil {
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessOperator(ctx, "/", opTok.Pos); err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
 
-// reduceExp: EXP -> TERMINO EXP_P
-func reduceExp(X []Attrib, C interface{}) (Attrib, error) {
-	// Solo pasamos, EXP_P ya procesó los operadores
-	return X[0], nil
-}
-
-// reduceExpPAdd: EXP_P -> "+" TERMINO EXP_P
-func reduceExpPAdd(X []Attrib, C interface{}) (Attrib, error) {
-	return X[2], nil
+// reduceModMark: MOD_MARK -> "%"
+func reduceModMark(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessOperator(ctx, "%", opTok.Pos); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// reduceExp: EXP -> TERMINO EXP_P
+func reduceExp(X []Attrib, C interface{}) (Attrib, error) {
+	// Solo pasamos, EXP_P ya procesó los operadores
+	return X[0], nil
+}
+
+// reduceExpPAdd: EXP_P -> "+" TERMINO EXP_P
+func reduceExpPAdd(X []Attrib, C interface{}) (Attrib, error) {
+	return X[2], nil
 }
 
 // reduceExpPSub: EXP_P -> "-" TERMINO EXP_P
@@ -722,24 +1199,32 @@ func reduceExpPSub(X []Attrib, C interface{}) (Attrib, error) {
 }
 
 // reduceAddMark: ADD_MARK -> "+"
-func reduceAddMark(_ []Attrib, C interface{}) (Attrib, error) {
+func reduceAddMark(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
-	if err := semantic.ProcessOperator(ctx, "+"); err != nil {
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessOperator(ctx, "+", opTok.Pos); err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
 
 // reduceSubMark: SUB_MARK -> "-"
-func reduceSubMark(_ []Attrib, C interface{}) (Attrib, error) {
+func reduceSubMark(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
-	if err := semantic.ProcessOperator(ctx, "-"); err != nil {
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessOperator(ctx, "-", opTok.Pos); err != nil {
 		return nil, err
 	}
 	return nil, nil
@@ -751,7 +1236,13 @@ func reduceRelOpGt(X []Attrib, C interface{}) (Attrib, error) {
 	if err != nil {
 		return nil, err
 	}
-	ctx.OpStack.Push(">")
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessRelationalOperator(ctx, ">", opTok.Pos); err != nil {
+		return nil, err
+	}
 	return X[0], nil
 }
 
@@ -761,7 +1252,13 @@ func reduceRelOpLt(X []Attrib, C interface{}) (Attrib, error) {
 	if err != nil {
 		return nil, err
 	}
-	ctx.OpStack.Push("<")
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessRelationalOperator(ctx, "<", opTok.Pos); err != nil {
+		return nil, err
+	}
 	return X[0], nil
 }
 
@@ -771,7 +1268,13 @@ func reduceRelOpNeq(X []Attrib, C interface{}) (Attrib, error) {
 	if err != nil {
 		return nil, err
 	}
-	ctx.OpStack.Push("!=")
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessRelationalOperator(ctx, "!=", opTok.Pos); err != nil {
+		return nil, err
+	}
 	return X[0], nil
 }
 
@@ -781,7 +1284,45 @@ func reduceRelOpEq(X []Attrib, C interface{}) (Attrib, error) {
 	if err != nil {
 		return nil, err
 	}
-	ctx.OpStack.Push("==")
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessRelationalOperator(ctx, "==", opTok.Pos); err != nil {
+		return nil, err
+	}
+	return X[0], nil
+}
+
+// reduceRelOpGte: REL_OP -> ">="
+func reduceRelOpGte(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessRelationalOperator(ctx, ">=", opTok.Pos); err != nil {
+		return nil, err
+	}
+	return X[0], nil
+}
+
+// reduceRelOpLte: REL_OP -> "<="
+func reduceRelOpLte(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	opTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessRelationalOperator(ctx, "<=", opTok.Pos); err != nil {
+		return nil, err
+	}
 	return X[0], nil
 }
 
@@ -798,19 +1339,67 @@ func reduceRelTail(X []Attrib, C interface{}) (Attrib, error) {
 	return X[1], nil
 }
 
-// reduceExpression: EXPRESSION -> EXP REL_TAIL
-func reduceExpression(X []Attrib, C interface{}) (Attrib, error) {
+// reduceRelExp: REL_EXP -> EXP REL_TAIL
+func reduceRelExp(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
-	// Si no hay REL_TAIL, solo procesar EXP
-	if X[1] == nil {
-		if err := semantic.ProcessExpressionEnd(ctx); err != nil {
-			return nil, err
-		}
+	// Resolver los operadores aritméticos pendientes hasta el fondo falso. Si
+	// hubo REL_TAIL, reduceRelTail ya generó el cuádruplo relacional y no
+	// queda nada por procesar.
+	if err := semantic.ProcessExpressionEnd(ctx); err != nil {
+		return nil, err
+	}
+	return X[0], nil
+}
+
+// reduceAndMark: AND_MARK -> "&&"
+func reduceAndMark(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessLogicalOperator(ctx, "&&"); err != nil {
+		return nil, err
+	}
+	return X[0], nil
+}
+
+// reduceOrMark: OR_MARK -> "||"
+func reduceOrMark(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessLogicalOperator(ctx, "||"); err != nil {
+		return nil, err
+	}
+	return X[0], nil
+}
+
+// reduceLogicalAnd: AND_EXP -> AND_EXP AND_MARK REL_EXP
+func reduceLogicalAnd(X []Attrib, C interface{}) (Attrib, error) {
+	return reduceLogical(X, C, "&&")
+}
+
+// reduceLogicalOr: EXPRESSION -> EXPRESSION OR_MARK AND_EXP
+func reduceLogicalOr(X []Attrib, C interface{}) (Attrib, error) {
+	return reduceLogical(X, C, "||")
+}
+
+func reduceLogical(X []Attrib, C interface{}, op string) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	opTok, err := tokenFromAttrib(X[1])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessLogicalEnd(ctx, op, opTok.Pos); err != nil {
+		return nil, err
 	}
-	// Si hay REL_TAIL, ya se procesó en reduceRelTail
 	return X[0], nil
 }
 
@@ -832,6 +1421,46 @@ func reduceAssign(X []Attrib, C interface{}) (Attrib, error) {
 	return nil, nil
 }
 
+// reduceAssignIndexed: ASSIGN -> id INDICES "=" EXPRESSION ";"
+func reduceAssignIndexed(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	idTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	indexPos, ok := X[1].([]token.Pos)
+	if !ok {
+		return nil, fmt.Errorf("esperaba índices, obtuvo %T", X[1])
+	}
+	if err := semantic.ProcessIndexedAssignment(ctx, idTok.IDValue(), idTok.Pos, indexPos); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// reduceAssignField: ASSIGN -> id "." id "=" EXPRESSION ";"
+func reduceAssignField(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	idTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	fieldTok, err := tokenFromAttrib(X[2])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessFieldAssignment(ctx, idTok.IDValue(), idTok.Pos, fieldTok.IDValue(), fieldTok.Pos); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
 // reduceEPrintExpression: E_PRINT -> EXPRESSION
 func reduceEPrintExpression(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
@@ -851,43 +1480,59 @@ func reduceEPrintExpression(X []Attrib, C interface{}) (Attrib, error) {
 	}
 	ctx.TypeStack.Pop() // Remover tipo
 	// Generar cuádruplo de print
-	semantic.ProcessPrint(ctx, result, false)
+	semantic.ProcessPrint(ctx, result)
 	return X[0], nil
 }
 
-// reduceEPrintString: E_PRINT -> cte_string
-func reduceEPrintString(X []Attrib, C interface{}) (Attrib, error) {
+// reduceRPrint: R_PRINT -> "," PRINT_P
+func reduceRPrint(X []Attrib, C interface{}) (Attrib, error) {
+	// Solo pasamos, PRINT_P ya procesó
+	return X[1], nil
+}
+
+// reduceReadTarget: READ_TARGET -> id
+// Cada variable de read genera su propio READ, en el orden en que aparece.
+func reduceReadTarget(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
-	// Main entry detection is now handled in generateQuadruple and reduceProgram
-	// No need to check here
-	tok, err := tokenFromAttrib(X[0])
+	idTok, err := tokenFromAttrib(X[0])
 	if err != nil {
 		return nil, err
 	}
-	// Generar cuádruplo de print para string
-	semantic.ProcessPrint(ctx, tok.StringValue(), true)
-	return X[0], nil
+	semantic.ProcessRead(ctx, string(idTok.Lit), idTok.Pos)
+	return nil, nil
 }
 
-// reduceRPrint: R_PRINT -> "," PRINT_P
-func reduceRPrint(X []Attrib, C interface{}) (Attrib, error) {
-	// Solo pasamos, PRINT_P ya procesó
-	return X[1], nil
+// reduceReadTargetField: READ_TARGET -> id "." id
+func reduceReadTargetField(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	idTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	fieldTok, err := tokenFromAttrib(X[2])
+	if err != nil {
+		return nil, err
+	}
+	semantic.ProcessReadField(ctx, idTok.IDValue(), idTok.Pos, fieldTok.IDValue(), fieldTok.Pos)
+	return nil, nil
 }
 
-// reduceIfMark: IF_MARK -> empty
-func reduceIfMark(_ []Attrib, C interface{}) (Attrib, error) {
+// reduceIfCond: IF_COND -> EXPRESSION
+func reduceIfCond(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
-	if _, err := semantic.ProcessIf(ctx); err != nil {
+	if _, err := semantic.ProcessIf(ctx, exprPos(X[0])); err != nil {
 		return nil, err
 	}
-	return nil, nil
+	return X[0], nil
 }
 
 // reduceElseMark: ELSE_MARK -> empty
@@ -902,58 +1547,253 @@ func reduceElseMark(_ []Attrib, C interface{}) (Attrib, error) {
 	return nil, nil
 }
 
-// reduceCondition: CONDITION -> "if" "(" EXPRESSION ")" IF_MARK BODY ";"
+// reduceCondition: ELSE_CHAIN -> empty
 func reduceCondition(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
-	// La EXPRESSION (X[2]) e IF_MARK ya procesaron la generación del GOTOF
-	// Al final del BODY, completar el if
+	// El último IF_COND de la cadena ya generó su GOTOF; al final de su BODY
+	// se completa para saltar fuera del if
 	if err := semantic.ProcessIfEnd(ctx); err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
 
-// reduceConditionElse: CONDITION -> "if" "(" EXPRESSION ")" IF_MARK BODY ELSE_MARK "else" BODY ";"
+// reduceConditionElse: ELSE_CHAIN -> ELSE_MARK "else" BODY
+//
+//	| ELSE_MARK "else" "if" "(" IF_COND ")" BODY ELSE_CHAIN
+//
+// ELSE_CHAIN es recursiva por la derecha, así que todas sus reducciones
+// ocurren al terminar la cadena: cada una completa el GOTO de salida de su
+// ELSE_MARK con el mismo índice, el final del if.
 func reduceConditionElse(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
-	// IF_MARK y ELSE_MARK ya generaron los saltos correspondientes; aquí sólo cerramos el if-else
+	// IF_COND y ELSE_MARK ya generaron los saltos correspondientes; aquí sólo cerramos el if-else
 	if err := semantic.ProcessIfElseEnd(ctx); err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
 
-// reduceWhileMark: WHILE_MARK -> empty
-func reduceWhileMark(_ []Attrib, C interface{}) (Attrib, error) {
+// reduceSwitchHead: SWITCH_HEAD -> "switch" "(" EXPRESSION ")"
+func reduceSwitchHead(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
-	if err := semantic.ProcessWhileCondition(ctx); err != nil {
+	if err := semantic.ProcessSwitchStart(ctx, exprPos(X[2])); err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
 
-// reduceCycle: CYCLE -> "while" "(" EXPRESSION ")" WHILE_MARK "do" BODY ";"
+// reduceCaseLabel: CASE_LABEL -> "case" cte_int ":" | "case" "-" cte_int ":"
+func reduceCaseLabel(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	valueTok, err := tokenFromAttrib(X[len(X)-2])
+	if err != nil {
+		return nil, err
+	}
+	value, convErr := strconv.Atoi(string(valueTok.Lit))
+	if convErr != nil {
+		ctx.Report(semantic.DiagnosticAt(semantic.CodeUnsupportedConstant, valueTok,
+			"constante int fuera de rango: %s", valueTok.Lit))
+	}
+	if len(X) == 4 {
+		value = -value
+	}
+	if err := semantic.ProcessCase(ctx, value, valueTok.Pos, len(valueTok.Lit)); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// reduceDefault: DEFAULT_LABEL -> "default" ":"
+func reduceDefault(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessDefault(ctx); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// reduceSwitch: SWITCH -> SWITCH_HEAD "{" CASES DEFAULT_CASE "}" ";"
+func reduceSwitch(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessSwitchEnd(ctx); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// reduceWhileStart: WHILE_START -> "while"
+// Guarda el índice donde empieza la evaluación de la condición.
+func reduceWhileStart(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	semantic.ProcessWhileStart(ctx)
+	return X[0], nil
+}
+
+// reduceWhileCond: WHILE_COND -> EXPRESSION
+func reduceWhileCond(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessWhileCondition(ctx, exprPos(X[0])); err != nil {
+		return nil, err
+	}
+	return X[0], nil
+}
+
+// reduceCycle: CYCLE -> WHILE_START "(" WHILE_COND ")" "do" BODY ";"
 func reduceCycle(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
 	if err != nil {
 		return nil, err
 	}
-	// WHILE_MARK ya evaluó la condición y generó el GOTOF; aquí sólo cerramos el ciclo
+	// WHILE_COND ya evaluó la condición y generó el GOTOF; aquí sólo cerramos el ciclo
 	if err := semantic.ProcessWhileEnd(ctx); err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
 
+// reduceDoStart: DO_START -> "do"
+func reduceDoStart(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	semantic.ProcessDoStart(ctx)
+	return X[0], nil
+}
+
+// reduceDoWhileMark: DO_WHILE -> "while"
+func reduceDoWhileMark(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	semantic.ProcessDoCondition(ctx)
+	return X[0], nil
+}
+
+// reduceDoCond: DO_COND -> EXPRESSION
+func reduceDoCond(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessDoWhileEnd(ctx, exprPos(X[0])); err != nil {
+		return nil, err
+	}
+	return X[0], nil
+}
+
+// reduceDoWhile: CYCLE -> DO_START BODY DO_WHILE "(" DO_COND ")" ";"
+// DO_COND ya generó el GOTOV; no queda nada por hacer.
+func reduceDoWhile(_ []Attrib, _ interface{}) (Attrib, error) {
+	return nil, nil
+}
+
+// reduceForInit: FOR_INIT -> "for" id "=" EXPRESSION
+func reduceForInit(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	idTok, err := tokenFromAttrib(X[1])
+	if err != nil {
+		return nil, err
+	}
+	return semantic.ProcessForInit(ctx, idTok.IDValue(), idTok.Pos, exprPos(X[3]))
+}
+
+// reduceForStep: FOR_STEP -> "step" EXPRESSION
+// El paso queda en la pila de operandos; se regresa su posición.
+func reduceForStep(X []Attrib, _ interface{}) (Attrib, error) {
+	return exprPos(X[1]), nil
+}
+
+// reduceForHead: FOR_HEAD -> FOR_INIT "to" EXPRESSION FOR_STEP
+func reduceForHead(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	loop, ok := X[0].(*semantic.ForLoop)
+	if !ok {
+		return nil, fmt.Errorf("esperaba *semantic.ForLoop, obtuvo %T", X[0])
+	}
+	stepPos, hasStep := X[3].(token.Pos)
+	if err := semantic.ProcessForHead(ctx, loop, exprPos(X[2]), stepPos, hasStep); err != nil {
+		return nil, err
+	}
+	return loop, nil
+}
+
+// reduceForLoop: CYCLE -> FOR_HEAD "do" BODY ";"
+func reduceForLoop(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	loop, ok := X[0].(*semantic.ForLoop)
+	if !ok {
+		return nil, fmt.Errorf("esperaba *semantic.ForLoop, obtuvo %T", X[0])
+	}
+	if err := semantic.ProcessForEnd(ctx, loop); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// reduceBreak: STATEMENT -> "break" ";"
+func reduceBreak(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	breakTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	semantic.ProcessBreak(ctx, breakTok.Pos)
+	return nil, nil
+}
+
+// reduceContinue: STATEMENT -> "continue" ";"
+func reduceContinue(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	continueTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	semantic.ProcessContinue(ctx, continueTok.Pos)
+	return nil, nil
+}
+
 // reduceReturn: RETURN -> "return" EXPRESSION ";"
 func reduceReturn(X []Attrib, C interface{}) (Attrib, error) {
 	ctx, err := semanticCtx(C)
@@ -978,7 +1818,11 @@ func reduceReturn(X []Attrib, C interface{}) (Attrib, error) {
 	}
 
 	// Process the return statement
-	if err := semantic.ProcessReturn(ctx, exprValue, exprType); err != nil {
+	returnTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessReturn(ctx, exprValue, exprType, returnTok.Pos); err != nil {
 		return nil, err
 	}
 
@@ -993,7 +1837,11 @@ func reduceReturnVoid(X []Attrib, C interface{}) (Attrib, error) {
 	}
 
 	// Process void return statement
-	if err := semantic.ProcessReturnVoid(ctx); err != nil {
+	returnTok, err := tokenFromAttrib(X[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessReturnVoid(ctx, returnTok.Pos); err != nil {
 		return nil, err
 	}
 
@@ -1069,3 +1917,44 @@ func reduceCallArgsOpen(X []Attrib, C interface{}) (Attrib, error) {
 	ctx.OpStack.Push("(")
 	return nil, nil
 }
+
+// reduceIndexOpen: INDEX_OPEN -> "["
+// Apila un fondo falso para evaluar la expresión del índice por separado.
+func reduceIndexOpen(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	ctx.OpStack.Push("(")
+	return X[0], nil
+}
+
+// reduceIndex: INDEX -> INDEX_OPEN EXPRESSION "]"
+// Deja el valor del índice en la pila de operandos y regresa su posición.
+func reduceIndex(X []Attrib, C interface{}) (Attrib, error) {
+	ctx, err := semanticCtx(C)
+	if err != nil {
+		return nil, err
+	}
+	if err := semantic.ProcessExpressionEnd(ctx); err != nil {
+		return nil, err
+	}
+	op, ok := ctx.OpStack.Pop()
+	if !ok || op != "(" {
+		return nil, fmt.Errorf("error interno: faltaba fondo falso de índice, se encontró %q", op)
+	}
+	return exprPos(X[1]), nil
+}
+
+// reduceIndices: INDICES -> INDEX | INDEX INDEX
+func reduceIndices(X []Attrib, _ interface{}) (Attrib, error) {
+	positions := make([]token.Pos, len(X))
+	for i, a := range X {
+		pos, ok := a.(token.Pos)
+		if !ok {
+			return nil, fmt.Errorf("esperaba posición de índice, obtuvo %T", a)
+		}
+		positions[i] = pos
+	}
+	return positions, nil
+}
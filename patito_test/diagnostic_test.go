@@ -0,0 +1,369 @@
+package parser_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	pwrap "Patito/pkg/parser"
+	"Patito/semantic"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func diagnose(t *testing.T, src string) *semantic.Diagnostic {
+	t.Helper()
+	p := pwrap.MustBuildParser()
+	_, err := pwrap.ParseString(p, "prog.patito", src)
+	require.Error(t, err)
+	var diag *semantic.Diagnostic
+	require.True(t, errors.As(err, &diag), "se esperaba *semantic.Diagnostic, se obtuvo %T", err)
+	return diag
+}
+
+// found resume un diagnóstico por código y línea para comparar listas.
+type found struct {
+	Code semantic.Code
+	Line int
+}
+
+// diagnoseAll parsea un programa con errores y regresa sus diagnósticos junto
+// con su resumen por código y línea.
+func diagnoseAll(t *testing.T, src string) (semantic.DiagnosticList, []found) {
+	t.Helper()
+	p := pwrap.MustBuildParser()
+	_, err := pwrap.ParseString(p, "prog.patito", src)
+	require.Error(t, err)
+	var diags semantic.DiagnosticList
+	require.True(t, errors.As(err, &diags), "se esperaba semantic.DiagnosticList, se obtuvo %T", err)
+	got := make([]found, len(diags))
+	for i, d := range diags {
+		got[i] = found{d.Code, d.Line}
+	}
+	return diags, got
+}
+
+func TestDiagnostic_UndeclaredVariable(t *testing.T) {
+	diag := diagnose(t, "program p;\nvar a: int;\nmain {\n  a = 1 + b;\n}\nend")
+	assert.Equal(t, semantic.CodeUndeclaredVariable, diag.Code)
+	assert.Equal(t, semantic.SeverityError, diag.Severity)
+	assert.Equal(t, "prog.patito", diag.File)
+	assert.Equal(t, 4, diag.Line)
+	assert.Equal(t, 11, diag.Column)
+	assert.Equal(t, 12, diag.EndColumn)
+	assert.Equal(t, "prog.patito:4:11: error[E0100]: variable 'b' no declarada", diag.Error())
+}
+
+func TestDiagnostic_SyntaxError(t *testing.T) {
+	diag := diagnose(t, "program p;\nmain {\n  print(1)\n}\nend")
+	assert.Equal(t, semantic.CodeSyntax, diag.Code)
+	assert.Equal(t, 4, diag.Line)
+	assert.Equal(t, 1, diag.Column)
+	assert.Contains(t, diag.Message, `expected ";"`)
+}
+
+// Un error dentro de `[ ... ]` dejaba la recuperación en un estado que reduce
+// con `error` y Parser.Error entraba en pánico.
+func TestDiagnostic_SyntaxErrorInBracketBlock(t *testing.T) {
+	sources := []string{
+		"program p; main { [ print(1); ]; } end",
+		"program p; main { [ var x: int; x = 1; ]; } end",
+		"program p; var y: int; main { while (y < 1) do [ y = y + 1; ]; ; } end",
+	}
+	for _, src := range sources {
+		t.Run(src, func(t *testing.T) {
+			require.NotPanics(t, func() {
+				_, err := pwrap.ParseString(pwrap.MustBuildParser(), "", src)
+				var diags semantic.DiagnosticList
+				require.True(t, errors.As(err, &diags), "%v", err)
+				assert.Equal(t, semantic.CodeSyntax, diags[0].Code)
+			})
+		})
+	}
+}
+
+func TestDiagnostic_MissingEnd(t *testing.T) {
+	diag := diagnose(t, "program p;\nmain {\n}\n")
+	assert.Equal(t, semantic.CodeSyntax, diag.Code)
+	assert.Equal(t, "expected end; got: end-of-file", diag.Message)
+}
+
+func TestDiagnostic_AssignmentMismatch(t *testing.T) {
+	diag := diagnose(t, "program p;\nvar a: int;\nmain {\n  a = 2.5 + 1;\n}\nend")
+	assert.Equal(t, semantic.CodeAssignmentMismatch, diag.Code)
+	assert.Equal(t, 4, diag.Line)
+	assert.Equal(t, 3, diag.Column)
+}
+
+func TestDiagnostic_ArgumentCount(t *testing.T) {
+	diag := diagnose(t, `program p;
+void f(x: int) { return; };
+main {
+  f(1, 2);
+}
+end`)
+	assert.Equal(t, semantic.CodeArgumentCount, diag.Code)
+	assert.Equal(t, 4, diag.Line)
+	assert.Equal(t, 3, diag.Column)
+}
+
+func TestDiagnostic_Render(t *testing.T) {
+	src := "program p;\nvar a: int;\nmain {\n  a = 1 + b;\n}\nend"
+	diag := diagnose(t, src)
+	var out bytes.Buffer
+	require.NoError(t, semantic.RenderDiagnostic(&out, diag, []byte(src)))
+	assert.Equal(t,
+		"prog.patito:4:11: error[E0100]: variable 'b' no declarada\n"+
+			"   4 |   a = 1 + b;\n"+
+			"     |           ^\n",
+		out.String())
+}
+
+func TestDiagnostic_ReportsAllErrors(t *testing.T) {
+	_, got := diagnoseAll(t, `program p;
+var a: int;
+    b c: float;
+main {
+    a = y + 1;
+    a = 2.5;
+    a = = 3;
+    g(1);
+    print(z);
+}
+end`)
+	assert.Equal(t, []found{
+		{semantic.CodeSyntax, 3},
+		{semantic.CodeUndeclaredVariable, 5},
+		{semantic.CodeAssignmentMismatch, 6},
+		{semantic.CodeSyntax, 7},
+		{semantic.CodeUndeclaredFunction, 8},
+		{semantic.CodeUndeclaredVariable, 9},
+	}, got)
+}
+
+func TestDiagnostic_NoCascadingErrors(t *testing.T) {
+	_, got := diagnoseAll(t, `program p;
+var a: int;
+main {
+    a = (y + 1) * 2 - y;
+}
+end`)
+	// Sólo los dos usos de 'y'
+	assert.Equal(t, []found{
+		{semantic.CodeUndeclaredVariable, 4},
+		{semantic.CodeUndeclaredVariable, 4},
+	}, got)
+}
+
+func TestDiagnostic_BreakOutsideLoop(t *testing.T) {
+	diag := diagnose(t, `program p;
+void f() {
+  while (true) do { break; };
+  continue;
+  return;
+};
+main { }
+end`)
+	assert.Equal(t, semantic.CodeLoopControl, diag.Code)
+	assert.Equal(t, 4, diag.Line)
+	assert.Equal(t, "continue fuera de un ciclo", diag.Message)
+}
+
+func TestDiagnostic_SwitchErrors(t *testing.T) {
+	diags, got := diagnoseAll(t, `program p;
+var f: float;
+main {
+  switch (f) { case 1: print(1); };
+  switch (2) { case 3: print(1); case -3: print(2); case 3: print(3); };
+}
+end`)
+	assert.Equal(t, []found{
+		{semantic.CodeSwitchType, 4},
+		{semantic.CodeDuplicateCase, 5},
+	}, got)
+	assert.Equal(t, 58, diags[1].Column)
+}
+
+func TestDiagnostic_InvalidEscape(t *testing.T) {
+	diag := diagnose(t, "program p;\nmain {\n  print(\"ñá \\q\");\n}\nend")
+	assert.Equal(t, semantic.CodeUnsupportedConstant, diag.Code)
+	assert.Equal(t, 3, diag.Line)
+	assert.Equal(t, 13, diag.Column)
+	assert.Equal(t, 15, diag.EndColumn)
+	assert.Contains(t, diag.Message, `secuencia de escape inválida \q`)
+
+	diag = diagnose(t, "program p;\nmain {\n  print(\"\\u{D800}\");\n}\nend")
+	assert.Equal(t, semantic.CodeUnsupportedConstant, diag.Code)
+	assert.Equal(t, "U+D800 no es un código Unicode válido", diag.Message)
+}
+
+func TestDiagnostic_ConstantErrors(t *testing.T) {
+	diags, got := diagnoseAll(t, `program p;
+var v: int;
+const A: int = v + 1;
+const B: int = 10 / 0;
+const B: int = 3;
+main {
+  B = 2;
+  read(B);
+}
+end`)
+	assert.Equal(t, []found{
+		{semantic.CodeNotConstant, 3},
+		{semantic.CodeNotConstant, 4},
+		{semantic.CodeDuplicateSymbol, 5},
+		{semantic.CodeConstantAssignment, 7},
+		{semantic.CodeConstantAssignment, 8},
+	}, got)
+	assert.Equal(t, "no se pudo calcular la constante 'B': división entre cero", diags[1].Message)
+}
+
+// Un arreglo que no cabe en su segmento se reporta en lugar de agotar las
+// direcciones del VirtualAddressManager.
+func TestDiagnostic_ArrayTooLarge(t *testing.T) {
+	diags, got := diagnoseAll(t, `program p;
+var a: int[9000];
+var m: int[100000][100000];
+var b, c: float[5000];
+var d: int[99999999999999999999];
+main {
+  [ var l: int[20000]; print(1); ]
+}
+end`)
+	assert.Equal(t, []found{
+		{semantic.CodeArraySize, 2},
+		{semantic.CodeArraySize, 3},
+		{semantic.CodeArraySize, 4},
+		{semantic.CodeArraySize, 5},
+		{semantic.CodeArraySize, 7},
+	}, got)
+	assert.Equal(t, "'c' no cabe en el segmento global: quedan 3999 direcciones", diags[2].Message)
+}
+
+// Un programa que agota los temporales o las constantes se reporta una vez
+// por segmento en lugar de detener el compilador.
+func TestDiagnostic_SegmentOverflow(t *testing.T) {
+	var temps, consts strings.Builder
+	for i := 0; i < 6000; i++ {
+		temps.WriteString("  x = x + 1 * 2;\n")
+	}
+	for i := 0; i < 10001; i++ {
+		fmt.Fprintf(&consts, "  x = %d;\n", i)
+	}
+	for name, body := range map[string]string{"temporal": temps.String(), "constante": consts.String()} {
+		diags, got := diagnoseAll(t, "program p;\nvar x: int;\nmain {\n"+body+"}\nend")
+		assert.Equal(t, []found{{semantic.CodeSegmentOverflow, 0}}, got, name)
+		assert.Equal(t, "se excedió el rango de direcciones del segmento "+name, diags[0].Message)
+	}
+}
+
+func TestDiagnostic_RecordErrors(t *testing.T) {
+	diags, got := diagnoseAll(t, `program p;
+type Punto = record { x: float; x: int; };
+var a: Punto;
+var b: Figura;
+var n: int;
+main {
+  a = 3;
+  n.x = 2;
+  a.z = 1;
+  a.x = "hola";
+}
+end`)
+	assert.Equal(t, []found{
+		{semantic.CodeDuplicateSymbol, 2},
+		{semantic.CodeUndeclaredType, 4},
+		{semantic.CodeRecordField, 7},
+		{semantic.CodeRecordField, 8},
+		{semantic.CodeRecordField, 9},
+		{semantic.CodeAssignmentMismatch, 10},
+	}, got)
+	assert.Equal(t, "el record Punto no tiene el campo 'z'", diags[4].Message)
+}
+
+func TestDiagnostic_RefArguments(t *testing.T) {
+	diags, got := diagnoseAll(t, `program p;
+const K: int = 3;
+var x: int; f: float;
+void inc(ref n: int) { n = n + 1; return; };
+main {
+  inc(3);
+  inc(x + 1);
+  inc(K);
+  inc(f);
+  inc(x);
+}
+end`)
+	assert.Equal(t, []found{
+		{semantic.CodeReferenceArgument, 6},
+		{semantic.CodeReferenceArgument, 7},
+		{semantic.CodeReferenceArgument, 8},
+		{semantic.CodeArgumentType, 9},
+	}, got)
+	assert.Equal(t, "argumento 1 en llamada a 'inc': el parámetro ref 'n' requiere una variable", diags[0].Message)
+}
+
+func TestDiagnostic_PrototypeErrors(t *testing.T) {
+	diags, got := diagnoseAll(t, `program p;
+int f(a: int);
+int g(a: int);
+void h(ref a: int);
+int nunca(x: float);
+float f(a: int) { return 1.0; };
+int g(a: int, b: int) { return a; };
+void h(a: int) { return; };
+main {
+  print(nunca(1.0));
+}
+end`)
+	// g(int, int) es otra sobrecarga, así que el prototipo g(int) queda sin definir
+	assert.Equal(t, []found{
+		{semantic.CodeUndefinedFunction, 3},
+		{semantic.CodeUndefinedFunction, 5},
+		{semantic.CodePrototypeMismatch, 6},
+		{semantic.CodePrototypeMismatch, 8},
+	}, got)
+	assert.Equal(t, "la función 'g(int)' se declaró con un prototipo pero nunca se definió", diags[0].Message)
+	assert.Equal(t, "la función 'nunca' se declaró con un prototipo pero nunca se definió", diags[1].Message)
+}
+
+func TestDiagnostic_BlockScopeErrors(t *testing.T) {
+	_, got := diagnoseAll(t, `program p;
+void f() [ var z: int; ] { z = 1; return; };
+main {
+  var a: int;
+  var a: float;
+  [ var b: int; b = 1; ]
+  b = 2;
+  z = 3;
+}
+end`)
+	assert.Equal(t, []found{
+		{semantic.CodeDuplicateSymbol, 5},
+		{semantic.CodeUndeclaredVariable, 7},
+		{semantic.CodeUndeclaredVariable, 8},
+	}, got)
+}
+
+func TestDiagnostic_OverloadErrors(t *testing.T) {
+	diags, got := diagnoseAll(t, `program p;
+void f(a: int, b: float) { return; };
+void f(a: float, b: int) { return; };
+int g(a: int) { return a; };
+float g(a: int) { return 1.0; };
+main {
+  f(1, 2);
+  f("x", 1);
+  f(1.0, 2);
+}
+end`)
+	assert.Equal(t, []found{
+		{semantic.CodeFunctionRedefinition, 5},
+		{semantic.CodeAmbiguousCall, 7},
+		{semantic.CodeNoMatchingOverload, 8},
+	}, got)
+	assert.Equal(t, "llamada ambigua a 'f' con los argumentos (int, int): f(int,float), f(float,int)", diags[1].Message)
+}
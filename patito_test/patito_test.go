@@ -68,7 +68,7 @@ func TestVars_MultiplesNombres(t *testing.T) {
 }
 
 func TestVars_TipoInvalido(t *testing.T) {
-	parseErr(t, `program p; var x:string; main { } end`) // sólo int|float
+	parseErr(t, `program p; var x:void; main { } end`) // sólo int|float|bool|string|char o un tipo record
 }
 
 func TestVars_FaltaColon(t *testing.T) {
@@ -152,6 +152,12 @@ func TestExpr_Relacionales(t *testing.T) {
 	parseOK(t, `program p; main { if (x != 0) { }; } end`)
 }
 
+func TestExpr_ComparacionesYModulo(t *testing.T) {
+	parseOK(t, `program p; var x: int; main { x = 7 % 3; if (x >= 1) { }; while (x <= 0) do { }; } end`)
+	parseErr(t, `program p; var f: float; main { f = 7.5 % 2; } end`)
+	parseErr(t, `program p; var x: int; main { if (x => 1) { }; } end`)
+}
+
 // 6) Casos borde
 
 func TestVacio(t *testing.T) {
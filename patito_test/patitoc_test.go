@@ -0,0 +1,309 @@
+package parser_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"Patito/semantic"
+	"Patito/vm"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeProgram(t *testing.T, src string) []byte {
+	t.Helper()
+	ctx := compileSource(t, src)
+	var buf bytes.Buffer
+	require.NoError(t, vm.NewPatitocWriter(&buf).Write(ctx))
+	return buf.Bytes()
+}
+
+func TestPatitoc_ReadCommittedArtifacts(t *testing.T) {
+	files, err := filepath.Glob("../test_programs/*.patitoc")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		prog, err := vm.LoadPatitoc(file)
+		require.NoError(t, err, file)
+		require.NotEmpty(t, prog.Quadruples, file)
+		assert.Equal(t, "END", prog.Quadruples[len(prog.Quadruples)-1].Operator, file)
+	}
+}
+
+func TestPatitoc_RoundTrip(t *testing.T) {
+	files, err := filepath.Glob("../test_programs/*.patito")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		require.NoError(t, err)
+		ctx := compileSource(t, string(src))
+
+		var buf bytes.Buffer
+		require.NoError(t, vm.NewPatitocWriter(&buf).Write(ctx), file)
+		prog, err := vm.NewPatitocReader(&buf).Read()
+		require.NoError(t, err, file)
+		assert.Equal(t, vm.ProgramFromContext(ctx), prog, file)
+	}
+}
+
+func TestPatitoc_InvalidMagic(t *testing.T) {
+	data := encodeProgram(t, `program p; main { print(1); } end`)
+	binary.LittleEndian.PutUint32(data[0:4], 0xDEADBEEF)
+	_, err := vm.NewPatitocReader(bytes.NewReader(data)).Read()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "número mágico")
+}
+
+func TestPatitoc_UnsupportedVersion(t *testing.T) {
+	data := encodeProgram(t, `program p; main { print(1); } end`)
+	binary.LittleEndian.PutUint16(data[4:6], vm.PATITOC_VERSION+1)
+	_, err := vm.NewPatitocReader(bytes.NewReader(data)).Read()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "versión")
+}
+
+func TestPatitoc_Truncated(t *testing.T) {
+	data := encodeProgram(t, `
+		program p;
+		var x: int;
+		int f(a: int) [var b: float;] { return a; };
+		main { x = f(2); print("x=", x); }
+		end`)
+	for n := 0; n < len(data); n++ {
+		_, err := vm.NewPatitocReader(bytes.NewReader(data[:n])).Read()
+		require.Error(t, err, "prefijo de %d bytes", n)
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF, "prefijo de %d bytes", n)
+		assert.True(t, strings.HasPrefix(err.Error(), "patitoc: archivo truncado"), err.Error())
+	}
+}
+
+func TestPatitoc_TrailingData(t *testing.T) {
+	data := encodeProgram(t, `program p; main { print(1); } end`)
+	data = append(data, 0x00)
+	_, err := vm.NewPatitocReader(bytes.NewReader(data)).Read()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "datos sobrantes")
+}
+
+func TestPatitoc_DeterministicOutput(t *testing.T) {
+	files, err := filepath.Glob("../test_programs/*.patito")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		require.NoError(t, err)
+		first := encodeProgram(t, string(src))
+		second := encodeProgram(t, string(src))
+		assert.Equal(t, first, second, "%s debe compilar a los mismos bytes", file)
+
+		golden, err := os.ReadFile(file + "c")
+		require.NoError(t, err)
+		assert.Equal(t, golden, first, "%s no coincide con su .patitoc de referencia", file)
+	}
+}
+
+func TestPatitoc_FunctionsInDeclarationOrder(t *testing.T) {
+	ctx := compileSource(t, `
+		program p;
+		void zeta()[] { return; };
+		void alfa()[] { return; };
+		void medio()[] { return; };
+		main { zeta(); alfa(); medio(); }
+		end`)
+	names := make([]string, 0, 3)
+	for _, fn := range ctx.Directory.OrderedFunctions() {
+		names = append(names, fn.Name)
+	}
+	assert.Equal(t, []string{"zeta", "alfa", "medio"}, names)
+}
+
+func TestPatitoc_NewOperatorsRoundTrip(t *testing.T) {
+	ctx := compileSource(t, `
+		program p;
+		var i: int;
+		main { i = 9 % 4; if (i >= 1 && i <= 2) { print(i); }; }
+		end`)
+	var buf bytes.Buffer
+	require.NoError(t, vm.NewPatitocWriter(&buf).Write(ctx))
+	prog, err := vm.NewPatitocReader(&buf).Read()
+	require.NoError(t, err)
+
+	ops := make(map[string]bool)
+	for _, quad := range prog.Quadruples {
+		ops[quad.Operator] = true
+	}
+	for _, op := range []string{"%", ">=", "<="} {
+		assert.True(t, ops[op], "falta el operador %s", op)
+	}
+	assert.Equal(t, vm.ProgramFromContext(ctx), prog)
+}
+
+func TestPatitoc_ArrayDimensionsRoundTrip(t *testing.T) {
+	ctx := compileSource(t, `
+		program p;
+		var a: int[4]; m: float[2][2];
+		void f() [var v: int[3];] { v[2] = a[1]; return; };
+		main { m[1][0] = a[3]; f(); }
+		end`)
+	var buf bytes.Buffer
+	require.NoError(t, vm.NewPatitocWriter(&buf).Write(ctx))
+	prog, err := vm.NewPatitocReader(&buf).Read()
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{4}, prog.Globals[0].Dims)
+	assert.Equal(t, []int{2, 2}, prog.Globals[1].Dims)
+	assert.Equal(t, []int{3}, prog.Functions["f"].Locals[0].Dims)
+	// Cada celda del arreglo tiene tipo en el mapa
+	assert.Equal(t, semantic.TypeFloat, prog.TypeMap[prog.Globals[1].Address+3])
+	assert.Equal(t, vm.ProgramFromContext(ctx), prog)
+}
+
+func TestPatitoc_StringAndCharRoundTrip(t *testing.T) {
+	ctx := compileSource(t, `
+		program p;
+		var s: string; c: char;
+		string f(x: string) { return x + "!"; };
+		main { s = f("hola"); c = 'ñ'; print(s, 1, c); }
+		end`)
+	var buf bytes.Buffer
+	require.NoError(t, vm.NewPatitocWriter(&buf).Write(ctx))
+	prog, err := vm.NewPatitocReader(&buf).Read()
+	require.NoError(t, err)
+
+	assert.Equal(t, semantic.TypeString, prog.TypeMap[prog.Globals[0].Address])
+	assert.Equal(t, semantic.TypeChar, prog.TypeMap[prog.Globals[1].Address])
+	assert.Equal(t, semantic.TypeString, prog.Functions["f"].ReturnType)
+	for _, c := range prog.Constants {
+		want := semantic.SegmentConstant
+		switch c.Type {
+		case semantic.TypeString:
+			want = semantic.SegmentString
+		case semantic.TypeChar:
+			want = semantic.SegmentChar
+		}
+		assert.Equal(t, want, semantic.SegmentOf(c.Address), "constante %q", c.Value)
+	}
+	assert.Equal(t, vm.ProgramFromContext(ctx), prog)
+}
+
+func TestPatitoc_DecodedStringConstants(t *testing.T) {
+	ctx := compileSource(t, `program p; main { print("línea\n\u{1F986}"); } end`)
+	var buf bytes.Buffer
+	require.NoError(t, vm.NewPatitocWriter(&buf).Write(ctx))
+	prog, err := vm.NewPatitocReader(&buf).Read()
+	require.NoError(t, err)
+	require.Len(t, prog.Constants, 1)
+	assert.Equal(t, "línea\n🦆", prog.Constants[0].Value)
+}
+
+func TestPatitoc_ConstantsOnlyInConstantTable(t *testing.T) {
+	ctx := compileSource(t, `
+		program p;
+		const N: int = 20 + 1;
+		var x: int;
+		const F: float = N;
+		main { x = N; print(F); }
+		end`)
+	prog := vm.ProgramFromContext(ctx)
+
+	require.Len(t, prog.Globals, 1, "las constantes no reservan direcciones globales")
+	_, ok := ctx.ConstantTable.Get("21", semantic.TypeInt)
+	assert.True(t, ok, "N debe quedar en la tabla de constantes")
+	_, ok = ctx.ConstantTable.Get("21", semantic.TypeFloat)
+	assert.True(t, ok, "F se convierte a float en compilación")
+	_, ok = ctx.ConstantTable.Get("20", semantic.TypeInt)
+	assert.False(t, ok, "los literales del valor no quedan en la tabla")
+	// El valor se calculó en compilación: no quedan cuádruplos de `20 + 1`
+	for _, quad := range prog.Quadruples {
+		assert.NotEqual(t, "+", quad.Operator, "%v", quad)
+	}
+}
+
+func TestPatitoc_RecordRoundTrip(t *testing.T) {
+	ctx := compileSource(t, `
+		program p;
+		type Punto = record { x, y: float; n: int; };
+		var a: Punto; i: int;
+		void f() [var v: Punto;] { v.n = a.n; return; };
+		main { a.x = 2; f(); }
+		end`)
+	var buf bytes.Buffer
+	require.NoError(t, vm.NewPatitocWriter(&buf).Write(ctx))
+	prog, err := vm.NewPatitocReader(&buf).Read()
+	require.NoError(t, err)
+
+	require.Len(t, prog.Records, 1)
+	assert.Equal(t, []vm.Field{
+		{Name: "x", Type: semantic.TypeFloat, Offset: 0},
+		{Name: "y", Type: semantic.TypeFloat, Offset: 1},
+		{Name: "n", Type: semantic.TypeInt, Offset: 2},
+	}, prog.Records[0].Fields)
+	assert.Same(t, prog.Records[0], prog.Globals[0].Record)
+	assert.Same(t, prog.Records[0], prog.Functions["f"].Locals[0].Record)
+	// Los campos son contiguos: la siguiente global empieza después del record
+	assert.Equal(t, prog.Globals[0].Address+3, prog.Globals[1].Address)
+	assert.Equal(t, semantic.TypeInt, prog.TypeMap[prog.Globals[0].Address+2])
+	assert.Equal(t, vm.ProgramFromContext(ctx), prog)
+}
+
+func TestPatitoc_RefParamsRoundTrip(t *testing.T) {
+	ctx := compileSource(t, `
+		program p;
+		var x: int;
+		void inc(ref n: int, k: int) { n = n + k; return; };
+		main { inc(x, 2); }
+		end`)
+	var buf bytes.Buffer
+	require.NoError(t, vm.NewPatitocWriter(&buf).Write(ctx))
+	prog, err := vm.NewPatitocReader(&buf).Read()
+	require.NoError(t, err)
+
+	params := prog.Functions["inc"].Params
+	require.Len(t, params, 2)
+	assert.True(t, params[0].ByRef)
+	assert.False(t, params[1].ByRef)
+	var ops []string
+	for _, quad := range prog.Quadruples {
+		if quad.Operator == "PARAM" || quad.Operator == "PARAMREF" {
+			ops = append(ops, quad.Operator+" "+quad.Operand1)
+		}
+	}
+	assert.Equal(t, []string{"PARAMREF 1000", "PARAM 30000"}, ops)
+	assert.Equal(t, vm.ProgramFromContext(ctx), prog)
+}
+
+func TestPatitoc_OverloadsRoundTrip(t *testing.T) {
+	ctx := compileSource(t, `
+		program p;
+		void f(a: int) { return; };
+		void f(ref a: float) { return; };
+		void g() { return; };
+		main { f(1); g(); }
+		end`)
+	var buf bytes.Buffer
+	require.NoError(t, vm.NewPatitocWriter(&buf).Write(ctx))
+	prog, err := vm.NewPatitocReader(&buf).Read()
+	require.NoError(t, err)
+
+	assert.Contains(t, prog.Functions, "f(int)")
+	assert.Contains(t, prog.Functions, "f(ref float)")
+	assert.Contains(t, prog.Functions, "g", "una función sin sobrecargas conserva su nombre")
+	var calls []string
+	for _, quad := range prog.Quadruples {
+		if quad.Operator == "ERA" || quad.Operator == "GOSUB" {
+			calls = append(calls, quad.Operator+" "+quad.Operand1)
+		}
+	}
+	assert.Equal(t, []string{"ERA f(int)", "GOSUB f(int)", "ERA g", "GOSUB g"}, calls)
+	assert.Equal(t, vm.ProgramFromContext(ctx), prog)
+}
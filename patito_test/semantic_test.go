@@ -1,6 +1,7 @@
 package parser_test
 
 import (
+	"errors"
 	"testing"
 
 	pwrap "Patito/pkg/parser"
@@ -91,3 +92,205 @@ func TestSemanticFunctionRedefinition(t *testing.T) {
 		end`)
 	assert.Error(t, err, "debe detectar redefinición de función")
 }
+
+func TestSemanticBoolDeclarations(t *testing.T) {
+	parser := pwrap.MustBuildParser()
+	res, err := pwrap.ParseString(parser, "", `
+		program demo;
+		var ok:bool;
+		bool neg(b:bool) { return !b; };
+		main { ok = neg(true && false); }
+		end`)
+	require.NoError(t, err)
+
+	dir := res.(*semantic.FunctionDirectory)
+	assert.Equal(t, semantic.TypeBool, dir.Globals.Entries()[0].Type)
+	fn, exists := dir.GetFunction("neg")
+	require.True(t, exists)
+	assert.Equal(t, semantic.TypeBool, fn.ReturnType)
+	assert.Equal(t, semantic.TypeBool, fn.Params.Entries()[0].Type)
+}
+
+func TestSemanticArrayDeclarations(t *testing.T) {
+	parser := pwrap.MustBuildParser()
+	res, err := pwrap.ParseString(parser, "", `
+		program demo;
+		var a, b: int[10];
+		    m: float[3][4];
+		    x: int;
+		void f() [var v: bool[2]; y: int;] { return; };
+		main { x = 1; }
+		end`)
+	require.NoError(t, err)
+
+	dir := res.(*semantic.FunctionDirectory)
+	globals := dir.Globals.Entries()
+	require.Len(t, globals, 4)
+	assert.Equal(t, []int{10}, globals[0].Dims)
+	assert.Equal(t, 1000, globals[0].Address)
+	assert.Equal(t, 1010, globals[1].Address)
+	assert.Equal(t, []int{3, 4}, globals[2].Dims)
+	assert.Equal(t, semantic.TypeFloat, globals[2].Type)
+	assert.Equal(t, 1032, globals[3].Address, "m ocupa 12 celdas")
+	assert.Nil(t, globals[3].Dims)
+
+	fn, exists := dir.GetFunction("f")
+	require.True(t, exists)
+	locals := fn.Locals.Entries()
+	assert.Equal(t, []int{2}, locals[0].Dims)
+	assert.Equal(t, locals[0].Address+2, locals[1].Address)
+}
+
+func TestSemanticArrayErrors(t *testing.T) {
+	cases := map[string]struct {
+		src  string
+		code semantic.Code
+	}{
+		"sin índices":         {`program p; var a:int[3]; x:int; main { x = a; } end`, semantic.CodeDimensionMismatch},
+		"asignar arreglo":     {`program p; var a:int[3]; main { a = 1; } end`, semantic.CodeDimensionMismatch},
+		"índices de más":      {`program p; var a:int[3]; x:int; main { x = a[1][1]; } end`, semantic.CodeDimensionMismatch},
+		"escalar indexado":    {`program p; var x:int; main { x[0] = 1; } end`, semantic.CodeDimensionMismatch},
+		"índice float":        {`program p; var a:int[3]; x:int; main { x = a[1.0]; } end`, semantic.CodeIndexType},
+		"tamaño cero":         {`program p; var a:int[0]; main { } end`, semantic.CodeArraySize},
+		"elemento mal tipado": {`program p; var a:int[3]; main { a[0] = 1.5; } end`, semantic.CodeAssignmentMismatch},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			parser := pwrap.MustBuildParser()
+			_, err := pwrap.ParseString(parser, "", tc.src)
+			var diag *semantic.Diagnostic
+			require.True(t, errors.As(err, &diag), "se esperaba diagnóstico: %v", err)
+			assert.Equal(t, tc.code, diag.Code)
+		})
+	}
+}
+
+func TestSemanticForRequiresInt(t *testing.T) {
+	cases := map[string]string{
+		"variable float": `program p; var x:float; main { for x = 1 to 3 do { }; } end`,
+		"límite float":   `program p; var i:int; main { for i = 1 to 2.5 do { }; } end`,
+		"paso bool":      `program p; var i:int; main { for i = 1 to 3 step true do { }; } end`,
+		"arreglo":        `program p; var a:int[2]; main { for a = 1 to 3 do { }; } end`,
+	}
+	for name, src := range cases {
+		t.Run(name, func(t *testing.T) {
+			parser := pwrap.MustBuildParser()
+			_, err := pwrap.ParseString(parser, "", src)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSemanticBoolRejectsArithmetic(t *testing.T) {
+	cases := map[string]string{
+		"aritmética":     `program p; var b:bool; i:int; main { i = b + 1; } end`,
+		"orden":          `program p; var b:bool; main { if (b > false) { }; } end`,
+		"and con int":    `program p; var b:bool; i:int; main { b = b && i; } end`,
+		"not con int":    `program p; var b:bool; i:int; main { b = !i; } end`,
+		"condición int":  `program p; var i:int; main { while (i) do { }; } end`,
+		"do-while int":   `program p; var i:int; main { do { } while (i); } end`,
+		"asignar a int":  `program p; var i:int; main { i = true; } end`,
+		"asignar de int": `program p; var b:bool; main { b = 1; } end`,
+	}
+	for name, src := range cases {
+		t.Run(name, func(t *testing.T) {
+			parser := pwrap.MustBuildParser()
+			_, err := pwrap.ParseString(parser, "", src)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSemanticReadTargets(t *testing.T) {
+	cases := map[string]struct {
+		src  string
+		code semantic.Code
+	}{
+		"no declarada": {`program p; var x:int; main { read(x, y); } end`, semantic.CodeUndeclaredVariable},
+		"arreglo":      {`program p; var a:int[3]; main { read(a); } end`, semantic.CodeDimensionMismatch},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			parser := pwrap.MustBuildParser()
+			_, err := pwrap.ParseString(parser, "", tc.src)
+			var diag *semantic.Diagnostic
+			require.True(t, errors.As(err, &diag), "se esperaba diagnóstico: %v", err)
+			assert.Equal(t, tc.code, diag.Code)
+		})
+	}
+}
+
+func TestSemanticStringOperations(t *testing.T) {
+	cases := map[string]struct {
+		src  string
+		code semantic.Code
+	}{
+		"asignar int":      {`program p; var s:string; main { s = 1; } end`, semantic.CodeAssignmentMismatch},
+		"concatenar int":   {`program p; var s:string; main { s = "a" + 1; } end`, semantic.CodeInvalidOperation},
+		"orden":            {`program p; var b:bool; main { b = "a" < "b"; } end`, semantic.CodeInvalidOperation},
+		"restar strings":   {`program p; var s:string; main { s = "a" - "b"; } end`, semantic.CodeInvalidOperation},
+		"len de int":       {`program p; var n:int; main { n = len(5); } end`, semantic.CodeArgumentType},
+		"len a string":     {`program p; var s:string; main { s = len("ab"); } end`, semantic.CodeAssignmentMismatch},
+		"argumento string": {`program p; void f(x:int) { return; }; main { f("a"); } end`, semantic.CodeArgumentType},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			parser := pwrap.MustBuildParser()
+			_, err := pwrap.ParseString(parser, "", tc.src)
+			var diag *semantic.Diagnostic
+			require.True(t, errors.As(err, &diag), "se esperaba diagnóstico: %v", err)
+			assert.Equal(t, tc.code, diag.Code)
+		})
+	}
+}
+
+func TestSemanticCharOperations(t *testing.T) {
+	cases := map[string]struct {
+		src  string
+		code semantic.Code
+	}{
+		"string a char": {`program p; var c:char; main { c = "a"; } end`, semantic.CodeAssignmentMismatch},
+		"char a int":    {`program p; var i:int; main { i = 'a'; } end`, semantic.CodeAssignmentMismatch},
+		"sumar chars":   {`program p; var c:char; main { c = 'a' + 'b'; } end`, semantic.CodeInvalidOperation},
+		"ord de int":    {`program p; var i:int; main { i = ord(1); } end`, semantic.CodeArgumentType},
+		"chr de char":   {`program p; var c:char; main { c = chr('a'); } end`, semantic.CodeArgumentType},
+		"char con int":  {`program p; var b:bool; main { b = 'a' == 97; } end`, semantic.CodeInvalidOperation},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			parser := pwrap.MustBuildParser()
+			_, err := pwrap.ParseString(parser, "", tc.src)
+			var diag *semantic.Diagnostic
+			require.True(t, errors.As(err, &diag), "se esperaba diagnóstico: %v", err)
+			assert.Equal(t, tc.code, diag.Code)
+		})
+	}
+}
+
+func TestSemanticConversionBuiltins(t *testing.T) {
+	ok := `program p; var x:int; f:float;
+	main { x = int(2.5) + round(f) + floor(f) + ceil(x) + abs(x); f = float(x) + abs(f); } end`
+	parser := pwrap.MustBuildParser()
+	_, err := pwrap.ParseString(parser, "", ok)
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		src  string
+		code semantic.Code
+	}{
+		"int de string": {`program p; var x:int; main { x = int("1"); } end`, semantic.CodeArgumentType},
+		"float a int":   {`program p; var x:int; main { x = float(1); } end`, semantic.CodeAssignmentMismatch},
+		"abs de bool":   {`program p; var x:int; main { x = abs(true); } end`, semantic.CodeArgumentType},
+		"round de char": {`program p; var x:int; main { x = round('a'); } end`, semantic.CodeArgumentType},
+		"abs float→int": {`program p; var x:int; main { x = abs(1.5); } end`, semantic.CodeAssignmentMismatch},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			parser := pwrap.MustBuildParser()
+			_, err := pwrap.ParseString(parser, "", tc.src)
+			var diag *semantic.Diagnostic
+			require.True(t, errors.As(err, &diag), "se esperaba diagnóstico: %v", err)
+			assert.Equal(t, tc.code, diag.Code)
+		})
+	}
+}
@@ -0,0 +1,645 @@
+package parser_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"Patito/lexer"
+	"Patito/parser"
+	"Patito/semantic"
+	"Patito/vm"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// compileSource replica el flujo de main.go: GOTO inicial, parseo y contexto.
+func compileSource(t *testing.T, src string) *semantic.Context {
+	t.Helper()
+	ctx := semantic.NewContext()
+	semantic.ProcessProgramStart(ctx)
+	p := parser.NewParser()
+	p.Context = ctx
+	_, err := p.Parse(lexer.NewLexer([]byte(src)))
+	require.NoError(t, err, "Debe compilar OK:\n%s", src)
+	require.Empty(t, ctx.Diagnostics, "Debe compilar sin diagnósticos:\n%s", src)
+	return ctx
+}
+
+func runSource(t *testing.T, src string) (string, error) {
+	t.Helper()
+	return runSourceWithInput(t, src, "")
+}
+
+func runSourceWithInput(t *testing.T, src, input string) (string, error) {
+	t.Helper()
+	ctx := compileSource(t, src)
+	var out bytes.Buffer
+	machine := vm.NewMachine(vm.ProgramFromContext(ctx))
+	machine.Output = &out
+	machine.Input = strings.NewReader(input)
+	err := machine.Run()
+	return out.String(), err
+}
+
+func TestVM_FibonacciRecursive(t *testing.T) {
+	src, err := os.ReadFile("../test_programs/test9_fibonacci_recursive.patito")
+	require.NoError(t, err)
+	out, err := runSource(t, string(src))
+	require.NoError(t, err)
+	assert.Equal(t, "Fibonacci de \n13\n es: \n233\n", out)
+}
+
+func TestVM_ArithmeticAndPromotion(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var a, b: int; f: float;
+		main {
+			a = 7;
+			b = a / 2 - -1;
+			f = a;
+			print(b, f / 2, a * 1.5);
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "4\n3.5\n10.5\n", out)
+}
+
+func TestVM_WhileAndIfElse(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var i: int;
+		main {
+			i = 0;
+			while (i < 3) do {
+				if (i == 1) { print("uno"); } else { print(i); };
+				i = i + 1;
+			};
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "0\nuno\n2\n", out)
+}
+
+func TestVM_VoidFunctionAndLocals(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var g: int;
+		void show(x: int) [var y: int;] {
+			y = x * 2;
+			g = g + y;
+			print(y);
+			return;
+		};
+		main {
+			show(1);
+			show(2);
+			print(g);
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "2\n4\n6\n", out)
+}
+
+func TestVM_DivisionByZero(t *testing.T) {
+	_, err := runSource(t, `
+		program p;
+		var a: int;
+		main { a = 0; print(1 / a); }
+		end`)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, vm.ErrDivisionByZero))
+	var runtimeErr *vm.RuntimeError
+	assert.True(t, errors.As(err, &runtimeErr))
+}
+
+func TestVM_BoolAndShortCircuit(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var a, b: bool; i: int;
+		bool esPar(n: int) {
+			print("llamada");
+			return n / 2 * 2 == n;
+		};
+		main {
+			a = true;
+			b = !a || false;
+			print(a, b, a == b);
+			i = 3;
+			if (i > 5 && esPar(i)) { print("no"); } else { print("and"); };
+			if (i < 5 || esPar(i)) { print("or"); };
+			if (esPar(i) || esPar(i + 1) && !b) { print("ok"); };
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "true\nfalse\nfalse\nand\nor\nllamada\nllamada\nok\n", out)
+}
+
+func TestVM_ParenthesesAndCompoundConditions(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var i, n: int;
+		main {
+			n = 2;
+			print(n * (n + 1), (n + 1) * (n - 1) / 3);
+			i = 0;
+			while (i + 1 < 5 && !(i == 3)) do { i = i + 1; };
+			print(i);
+			if (n + 2 > 3) { print("si"); };
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "6\n1\n3\nsi\n", out)
+}
+
+func TestVM_ComparisonsAndModulo(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var i: int;
+		main {
+			i = 0;
+			while (i <= 10) do {
+				if (i % 3 == 0 && i >= 3) { print(i); };
+				i = i + 1;
+			};
+			print(17 % 5 * 2, 7 - 7 % 4, 2.5 >= 2, 2 <= 1.5);
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "3\n6\n9\n4\n4\ntrue\nfalse\n", out)
+}
+
+func TestVM_ModuloByZero(t *testing.T) {
+	_, err := runSource(t, `
+		program p;
+		var a: int;
+		main { a = 0; print(5 % a); }
+		end`)
+	assert.True(t, errors.Is(err, vm.ErrDivisionByZero))
+}
+
+func TestVM_Arrays(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var a: int[5];
+		    m: float[2][3];
+		    i, j, t: int;
+		int suma() [var k, s: int; v: int[3];] {
+			k = 0;
+			while (k < 3) do { v[k] = a[k] * 2; k = k + 1; };
+			s = v[0] + v[1] + v[2];
+			return s;
+		};
+		main {
+			a[0] = 5; a[1] = 3; a[2] = 4; a[3] = 1; a[4] = 2;
+			i = 0;
+			while (i < 4) do {
+				j = 0;
+				while (j < 4 - i) do {
+					if (a[j] > a[j + 1]) { t = a[j]; a[j] = a[j + 1]; a[j + 1] = t; };
+					j = j + 1;
+				};
+				i = i + 1;
+			};
+			print(a[0], a[4]);
+			m[1][2] = 7;
+			m[0][a[1]] = 2.5;
+			print(m[1][2] + m[0][2], m[a[0]][1], suma());
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "1\n5\n9.5\n0\n12\n", out)
+}
+
+func TestVM_ArrayIndexOutOfRange(t *testing.T) {
+	_, err := runSource(t, `
+		program p;
+		var m: int[2][3]; i: int;
+		main { i = 3; m[1][i] = 1; }
+		end`)
+	assert.True(t, errors.Is(err, vm.ErrIndexOutOfRange))
+}
+
+func TestVM_ForLoop(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var i, n, s: int;
+		main {
+			n = 4;
+			for i = 1 to n do { s = s + i; n = 0; };
+			print(s, i);
+			for i = 10 to 1 step -4 do { print(i); };
+			for i = 1 to 0 do { print(99); };
+		}
+		end`)
+	require.NoError(t, err)
+	// El límite se evalúa una sola vez aunque n cambie dentro del ciclo
+	assert.Equal(t, "10\n5\n10\n6\n2\n", out)
+}
+
+func TestVM_BreakAndContinue(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var i, j: int;
+		main {
+			while (true) do {
+				i = i + 1;
+				if (i % 2 == 0) { continue; };
+				if (i > 5) { break; };
+				print(i);
+			};
+			for i = 1 to 3 do {
+				if (i == 2) { continue; };
+				for j = 1 to 9 do { if (j > 2) { break; }; print(i * 10 + j); };
+			};
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "1\n3\n5\n11\n12\n31\n32\n", out)
+}
+
+func TestVM_DoWhile(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var i: int;
+		main {
+			do { print(i); i = i + 2; } while (i < 5);
+			do { i = i + 1; if (i == 8) { continue; }; if (i > 9) { break; }; print(i); } while (true);
+			do { print(-1); } while (false);
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "0\n2\n4\n7\n9\n-1\n", out)
+}
+
+func TestVM_ElseIf(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var i: int;
+		main {
+			for i = 0 to 3 do {
+				if (i == 0) { print("a"); } else if (i == 1) { print("b"); } else if (i == 2) { print("c"); } else { print("d"); };
+				if (i > 1) { print(i); } else if (i == 1) { print(-1); };
+			};
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "a\nb\n-1\nc\n2\nd\n3\n", out)
+}
+
+func TestVM_Switch(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var i: int;
+		main {
+			for i = 0 to 4 do {
+				switch (i - 1) {
+					case -1: print("menos uno");
+					case 1: print("uno"); break; print("nunca");
+					case 2: if (i == 3) { continue; }; print("no");
+					default: print("otro", i);
+				};
+				print(i);
+			};
+			switch (i) { case 0: print("cero"); };
+			switch (i) { default: print("default"); };
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "menos uno\n0\notro\n1\n1\nuno\n2\notro\n4\n4\ndefault\n", out)
+}
+
+func TestVM_Read(t *testing.T) {
+	src := `
+		program p;
+		var n: int; f: float; b: bool;
+		void doble() [ var x: float; ] {
+			read(x);
+			print(x * 2);
+			return;
+		};
+		main {
+			read(n, f);
+			read(b);
+			print(n + 1, f, b);
+			doble();
+		}
+		end`
+	out, err := runSourceWithInput(t, src, "41 2.5\n  true\n3\n")
+	require.NoError(t, err)
+	assert.Equal(t, "42\n2.5\ntrue\n6\n", out)
+
+	_, err = runSourceWithInput(t, src, "1.5")
+	assert.True(t, errors.Is(err, vm.ErrInvalidInput), "se esperaba ErrInvalidInput, se obtuvo %v", err)
+	assert.Contains(t, err.Error(), `se esperaba un valor int, se leyó "1.5"`)
+
+	_, err = runSourceWithInput(t, src, "1 2")
+	assert.True(t, errors.Is(err, vm.ErrInvalidInput), "se esperaba ErrInvalidInput, se obtuvo %v", err)
+	assert.Contains(t, err.Error(), "la entrada terminó")
+}
+
+func TestVM_Strings(t *testing.T) {
+	out, err := runSourceWithInput(t, `
+		program p;
+		var s: string; n: int; l: string[2];
+		string saluda(nombre: string) [ var r: string; ] {
+			r = "hola, " + nombre;
+			return r + "!";
+		};
+		main {
+			s = saluda("mundo");
+			print(s, len(s), len(""));
+			l[0] = "ab"; l[1] = l[0] + "c";
+			n = len(l[1]) * 2;
+			print(l[1], n);
+			if (s != "x" && l[0] == "ab") { print("ok"); };
+			read(s);
+			print(s + s);
+		}
+		end`, "eco\n")
+	require.NoError(t, err)
+	assert.Equal(t, "hola, mundo!\n12\n0\nabc\n6\nok\necoeco\n", out)
+}
+
+func TestVM_StringEscapes(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		main {
+			print("a\tb\n\"c\" \\ ñandú \u{1F986}\u{e9}");
+			print(len("ñ\u{1F986}\n"), "\"" == "\u{22}");
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "a\tb\n\"c\" \\ ñandú 🦆é\n3\ntrue\n", out)
+}
+
+func TestVM_Char(t *testing.T) {
+	out, err := runSourceWithInput(t, `
+		program p;
+		var c: char; i: int;
+		char siguiente(x: char) { return chr(ord(x) + 1); };
+		main {
+			c = siguiente('a');
+			print(c, ord(c), chr(241), '\'', '\\', 'ñ');
+			print(c > 'a', c == 'b', 'Z' < 'a');
+			for i = ord('x') to ord('z') do { print(chr(i)); };
+			read(c);
+			print(c);
+		}
+		end`, "ü")
+	require.NoError(t, err)
+	assert.Equal(t, "b\n98\nñ\n'\n\\\nñ\ntrue\ntrue\ntrue\nx\ny\nz\nü\n", out)
+
+	_, err = runSource(t, `program p; var c: char; main { c = chr(-1); } end`)
+	var rerr *vm.RuntimeError
+	require.True(t, errors.As(err, &rerr), "se esperaba RuntimeError, se obtuvo %v", err)
+	assert.Equal(t, "CHR", rerr.Operator)
+}
+
+func TestVM_NumericConversions(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var x: int; f: float;
+		main {
+			x = int(7.0 / 2.0);
+			f = float(7) / 2;
+			print(x, f, int(-3.7), round(2.5), round(-2.5), floor(-1.5), ceil(1.2));
+			print(abs(-4), abs(-2.5), abs(3), float(x) * 2);
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "3\n3.5\n-3\n3\n-3\n-2\n2\n4\n2.5\n3\n6\n", out)
+
+	_, err = runSource(t, `
+		program p;
+		var x: int; f: float;
+		main {
+			f = 1.0;
+			while (f < 1000000000000000000000.0) do { f = f * 10.0; };
+			x = round(f);
+		}
+		end`)
+	var rerr *vm.RuntimeError
+	require.True(t, errors.As(err, &rerr), "se esperaba RuntimeError, se obtuvo %v", err)
+	assert.Equal(t, "ROUND", rerr.Operator)
+}
+
+func TestVM_Constants(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		const LIMITE: int = 10;
+		var i, total: int;
+		const MITAD: float = LIMITE / 4.0;
+		const NOMBRE: string = "Pat" + "ito";
+		const GRANDE: bool = LIMITE > 5 && !(MITAD < 1.0);
+		const CORTO: bool = LIMITE < 5 && LIMITE / 0 > 1;
+		int escala(x: int) [ const K: int = -LIMITE; var y: int; ] {
+			y = x * K;
+			return y;
+		};
+		main {
+			for i = 1 to LIMITE step 3 do { total = total + escala(i); };
+			print(total, MITAD, NOMBRE, GRANDE, len(NOMBRE) + LIMITE, CORTO);
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "-220\n2.5\nPatito\ntrue\n16\nfalse\n", out)
+}
+
+func TestVM_ConstantShadowing(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		const N: int = 3;
+		void f(N: int) { print(N); return; };
+		int g() [ const N: int = N * 10; ] { return N; };
+		main {
+			[ var N: int; N = 5; print(N); ]
+			print(N);
+			f(7);
+			print(g());
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "5\n3\n7\n30\n", out)
+}
+
+func TestVM_Records(t *testing.T) {
+	out, err := runSourceWithInput(t, `
+		program p;
+		type Punto = record { x, y: float; etiqueta: string; };
+		var a, b: Punto;
+		float norma1(dx: float, dy: float) [ var r: Punto; ] {
+			r.x = dx;
+			r.y = dy;
+			if (r.x < 0) { r.x = -r.x; };
+			return r.x + r.y;
+		};
+		main {
+			a.x = 1.5;
+			a.y = 2;
+			b.x = a.x + a.y;
+			read(b.etiqueta);
+			print(b.x, b.y, b.etiqueta, norma1(0 - a.x, a.y));
+		}
+		end`, "origen\n")
+	require.NoError(t, err)
+	assert.Equal(t, "3.5\n0\norigen\n3.5\n", out)
+}
+
+func TestVM_RefParams(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var x, y: int; v: int[3];
+		void swap(ref a: int, ref b: int) [ var t: int; ] {
+			t = a;
+			a = b;
+			b = t;
+			return;
+		};
+		void inc(ref n: int, k: int) { n = n + k; k = 0; return; };
+		void twice(ref n: int) { inc(n, 1); inc(n, 1); return; };
+		main {
+			x = 1; y = 2;
+			swap(x, y);
+			v[1] = 10;
+			inc(v[1], y);
+			twice(v[2]);
+			print(x, y, v[1], v[2]);
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "2\n1\n11\n2\n", out)
+}
+
+func TestVM_MutualRecursion(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var i: int;
+		bool esPar(n: int);
+		bool esImpar(k: int);
+		bool esPar(n: int) {
+			if (n == 0) { return true; };
+			return esImpar(n - 1);
+		};
+		bool esImpar(n: int) {
+			if (n == 0) { return false; };
+			return esPar(n - 1);
+		};
+		main {
+			for i = 0 to 3 do { print(esPar(i)); };
+			print(esImpar(7));
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "true\nfalse\ntrue\nfalse\ntrue\n", out)
+}
+
+func TestVM_BlockScopes(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var x: int;
+		int suma(n: int) [ var total: int; ] {
+			total = 0;
+			while (n > 0) do {
+				var paso: int;
+				paso = paso + n;
+				total = total + paso;
+				n = n - 1;
+			};
+			return total;
+		};
+		main {
+			var x: float;
+			var i: int;
+			x = 1;
+			[
+				var x: string;
+				x = "sombra";
+				print(x);
+			]
+			print(x / 2);
+			for i = 1 to 2 do {
+				var s: string;
+				s = s + "a";
+				print(s);
+			};
+			print(suma(3));
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "sombra\n0.5\na\na\n6\n", out)
+}
+
+func TestVM_GlobalShadowing(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		var x: int;
+		void f(x: string) { x = x + "!"; print(x); return; };
+		void g() { x = x + 1; return; };
+		main {
+			x = 10;
+			[
+				var x: float;
+				x = 2.5;
+				print(x);
+			]
+			f("param");
+			g();
+			print(x);
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "2.5\nparam!\n11\n", out)
+}
+
+func TestVM_BlockScopesReuseAddresses(t *testing.T) {
+	ctx := compileSource(t, `
+		program p;
+		main {
+			var i: int;
+			[ var a: int; a = 1; ]
+			[ var b: float[2]; b[0] = 1.5; ]
+		}
+		end`)
+	var inits []semantic.Quadruple
+	for _, q := range ctx.Quadruples.Get() {
+		if q.Operator == "INIT" {
+			inits = append(inits, q)
+		}
+	}
+	assert.Equal(t, []semantic.Quadruple{
+		{Operator: "INIT", Operand1: "int", Operand2: "1", Result: "10000"},
+		{Operator: "INIT", Operand1: "int", Operand2: "1", Result: "10001"},
+		{Operator: "INIT", Operand1: "float", Operand2: "2", Result: "10001"},
+	}, inits, "los bloques hermanos comparten direcciones locales")
+}
+
+func TestVM_Overloads(t *testing.T) {
+	out, err := runSource(t, `
+		program p;
+		int max(a: int, b: int);
+		int mayor() { return max(2, 9); };
+		int max(a: int, b: int) {
+			if (a > b) { return a; };
+			return b;
+		};
+		float max(a: float, b: float) {
+			if (a > b) { return a; };
+			return b;
+		};
+		void show(x: int) { print("int"); return; };
+		void show(x: float) { print("float"); return; };
+		float mitad(x: float) { return x / 2; };
+		main {
+			print(max(1, 2), max(1.5, 0.5), max(1, 2.5), mayor());
+			show(1);
+			show(1.0);
+			print(mitad(3));
+		}
+		end`)
+	require.NoError(t, err)
+	assert.Equal(t, "2\n1.5\n2.5\n9\nint\nfloat\n1.5\n", out)
+}
@@ -19,9 +19,20 @@ func MustBuildParser() *Adapter {
 	return &Adapter{p: p}
 }
 
-// ParseString matches the signature your tests expect.
-func (a *Adapter) ParseString(_filename, src string) (interface{}, error) {
-	return a.p.Parse(lexer.NewLexer([]byte(src)))
+// ParseString matches the signature your tests expect. Errors are returned
+// as a semantic.DiagnosticList with every problem found in src; filename,
+// when given, is used in their location.
+func (a *Adapter) ParseString(filename, src string) (interface{}, error) {
+	l := lexer.NewLexer([]byte(src))
+	if filename != "" {
+		l.Context = &lexer.SourceContext{Filepath: filename}
+	}
+	res, err := a.p.Parse(l)
+	ctx, _ := a.SemanticContext()
+	if diags := parser.DiagnoseAll(ctx, err, []byte(src)); diags != nil {
+		return res, diags
+	}
+	return res, nil
 }
 
 // SemanticContext devuelve el contexto semántico subyacente.
@@ -0,0 +1,188 @@
+package semantic
+
+import (
+	"fmt"
+	"strings"
+
+	"Patito/token"
+)
+
+// ArraySize devuelve el número de celdas que ocupa una variable con las
+// dimensiones dadas (1 para escalares).
+func ArraySize(dims []int) int {
+	size := 1
+	for _, d := range dims {
+		size *= d
+	}
+	return size
+}
+
+// ArrayFits indica si un arreglo con las dimensiones dadas cabe en available
+// celdas. Compara dimensión por dimensión para que el producto no se desborde.
+func ArrayFits(dims []int, available int) bool {
+	size := 1
+	for _, d := range dims {
+		if d > available/size {
+			return false
+		}
+		size *= d
+	}
+	return size <= available
+}
+
+// PointerOperand marca un temporal como indirecto: `(t)` se refiere a la
+// dirección guardada en t y no a t. Así se leen y escriben elementos de
+// arreglos cuya dirección se calcula en ejecución.
+func PointerOperand(temp string) string {
+	return "(" + temp + ")"
+}
+
+// IsPointerOperand indica si un operando de cuádruplo usa la forma `(t)`.
+func IsPointerOperand(operand string) bool {
+	return strings.HasPrefix(operand, "(") && strings.HasSuffix(operand, ")")
+}
+
+// constantOperand devuelve la dirección de una constante int, agregándola a
+// la tabla si no existía.
+func constantOperand(ctx *Context, value int) string {
+	return AddressToString(constantAddress(ctx, fmt.Sprintf("%d", value), TypeInt))
+}
+
+// checkNotArray reporta el uso de un arreglo completo donde se espera un valor.
+func checkNotArray(ctx *Context, varName string, pos token.Pos) bool {
+	dims := GetVariableDimsFromContext(ctx, varName)
+	if len(dims) == 0 {
+		return true
+	}
+	ctx.Report(NewDiagnostic(CodeDimensionMismatch, pos, len(varName),
+		"el arreglo '%s' debe usarse con %d índice(s)", varName, len(dims)))
+	return false
+}
+
+// ProcessArrayAccess desapila los índices de `nombre[i]` o `nombre[i][j]` y
+// apila el elemento como operando indirecto. indexPos tiene la posición de
+// cada expresión de índice, en orden.
+func ProcessArrayAccess(ctx *Context, varName string, pos token.Pos, indexPos []token.Pos) error {
+	operand, elemType, err := arrayElement(ctx, varName, pos, indexPos)
+	if err != nil {
+		return err
+	}
+	PushOperand(ctx, operand, elemType)
+	return nil
+}
+
+// ProcessIndexedAssignment procesa `nombre[i] = expr`. La pila de operandos
+// tiene los índices del destino debajo del resultado de la expresión.
+func ProcessIndexedAssignment(ctx *Context, varName string, pos token.Pos, indexPos []token.Pos) error {
+	if err := ProcessExpressionEnd(ctx); err != nil {
+		return err
+	}
+	result, ok := ctx.OperandStack.Pop()
+	if !ok {
+		return internalError("no hay resultado de expresión para asignar")
+	}
+	resultType, _ := ctx.TypeStack.Pop()
+
+	target, elemType, err := arrayElement(ctx, varName, pos, indexPos)
+	if err != nil {
+		return err
+	}
+	if elemType == TypeInvalid || resultType == TypeInvalid {
+		return nil
+	}
+	if _, err := ctx.Cube.Result(OpAssign, elemType, resultType); err != nil {
+		ctx.Report(NewDiagnostic(CodeAssignmentMismatch, pos, len(varName),
+			"no se puede asignar un valor %s a un elemento de '%s' de tipo %s", resultType, varName, elemType))
+		return nil
+	}
+
+	generateQuadruple(ctx, "=", result, "", target)
+	return nil
+}
+
+// arrayElement desapila los índices, valida contra las dimensiones del
+// arreglo y genera el cálculo de la dirección del elemento:
+//
+//	VERIFY i, 0, n-1               (a[i])
+//	+      i, base, t
+//
+//	VERIFY i, 0, filas-1           (m[i][j])
+//	*      i, columnas, t1
+//	VERIFY j, 0, columnas-1
+//	+      t1, j, t2
+//	+      t2, base, t3
+//
+// Los límites de VERIFY son literales, igual que el destino de un GOTO. Si
+// hay un error se regresa TypeInvalid sin generar cuádruplos.
+func arrayElement(ctx *Context, varName string, pos token.Pos, indexPos []token.Pos) (string, Type, error) {
+	indices := make([]string, len(indexPos))
+	indexTypes := make([]Type, len(indexPos))
+	for i := len(indexPos) - 1; i >= 0; i-- {
+		value, ok := ctx.OperandStack.Pop()
+		if !ok {
+			return "", TypeInvalid, internalError("faltan índices para el arreglo '%s'", varName)
+		}
+		indices[i] = value
+		indexTypes[i], _ = ctx.TypeStack.Pop()
+	}
+
+	if _, ok := LookupConstant(ctx, varName); ok {
+		ctx.Report(NewDiagnostic(CodeDimensionMismatch, pos, len(varName), "la constante '%s' no es un arreglo", varName))
+		return varName, TypeInvalid, nil
+	}
+	varType, err := GetVariableTypeFromContext(ctx, varName)
+	if err != nil {
+		ctx.Report(NewDiagnostic(CodeUndeclaredVariable, pos, len(varName), "variable '%s' no declarada", varName))
+		return varName, TypeInvalid, nil
+	}
+	dims := GetVariableDimsFromContext(ctx, varName)
+	if len(dims) == 0 {
+		ctx.Report(NewDiagnostic(CodeDimensionMismatch, pos, len(varName), "la variable '%s' no es un arreglo", varName))
+		return varName, TypeInvalid, nil
+	}
+	if len(dims) != len(indices) {
+		ctx.Report(NewDiagnostic(CodeDimensionMismatch, pos, len(varName),
+			"el arreglo '%s' tiene %d dimensión(es), se usaron %d índice(s)", varName, len(dims), len(indices)))
+		return varName, TypeInvalid, nil
+	}
+
+	valid := true
+	for i, t := range indexTypes {
+		if t == TypeInvalid {
+			valid = false
+		} else if t != TypeInt {
+			ctx.Report(NewDiagnostic(CodeIndexType, indexPos[i], 1,
+				"el índice de '%s' debe ser int, se obtuvo %s", varName, t))
+			valid = false
+		}
+	}
+	if !valid {
+		return varName, TypeInvalid, nil
+	}
+
+	base, err := GetVariableAddressFromContext(ctx, varName)
+	if err != nil {
+		ctx.Report(NewDiagnostic(CodeUndeclaredVariable, pos, len(varName), "%s", err))
+		return varName, TypeInvalid, nil
+	}
+
+	// El valor de una constante no puede leer arreglos; no se generan cuádruplos
+	if markNotConstant(ctx) {
+		return varName, varType, nil
+	}
+
+	// Desplazamiento en orden por renglones: ((i * d1) + j)
+	offset := indices[0]
+	generateQuadruple(ctx, "VERIFY", offset, "0", fmt.Sprintf("%d", dims[0]-1))
+	for d := 1; d < len(dims); d++ {
+		scaled := ctx.NewTemp()
+		generateQuadruple(ctx, "*", offset, constantOperand(ctx, dims[d]), scaled)
+		generateQuadruple(ctx, "VERIFY", indices[d], "0", fmt.Sprintf("%d", dims[d]-1))
+		offset = ctx.NewTemp()
+		generateQuadruple(ctx, "+", scaled, indices[d], offset)
+	}
+
+	pointer := ctx.NewTemp()
+	generateQuadruple(ctx, "+", offset, constantOperand(ctx, base), pointer)
+	return PointerOperand(pointer), varType, nil
+}
@@ -0,0 +1,56 @@
+package semantic
+
+import "Patito/token"
+
+// builtinQuads asocia cada función predefinida con el cuádruplo que genera.
+// Los tipos que acepta cada una están en el cubo semántico.
+var builtinQuads = map[Operator]string{
+	OpLen:     "LEN",
+	OpOrd:     "ORD",
+	OpChr:     "CHR",
+	OpToInt:   "INT",
+	OpToFloat: "FLOAT",
+	OpRound:   "ROUND",
+	OpFloor:   "FLOOR",
+	OpCeil:    "CEIL",
+	OpAbs:     "ABS",
+}
+
+// ProcessBuiltin aplica una función predefinida (`len`, `int`, `round`, ...)
+// al valor en la cima de la pila y genera `(OP, x, , t)`. argPos es la
+// posición del argumento.
+func ProcessBuiltin(ctx *Context, name string, argPos token.Pos) error {
+	arg, ok := ctx.OperandStack.Pop()
+	if !ok {
+		return internalError("no hay argumento para %s", name)
+	}
+	argType, _ := ctx.TypeStack.Pop()
+
+	op := Operator(name)
+	quad, ok := builtinQuads[op]
+	if !ok {
+		return internalError("función predefinida desconocida '%s'", name)
+	}
+	if argType == TypeInvalid {
+		PushOperand(ctx, arg, TypeInvalid)
+		return nil
+	}
+	resultType, err := ctx.Cube.ResultUnary(op, argType)
+	if err != nil {
+		ctx.Report(NewDiagnostic(CodeArgumentType, argPos, 1, "%s no acepta un argumento %s", name, argType))
+		PushOperand(ctx, arg, TypeInvalid)
+		return nil
+	}
+
+	if d := ctx.ConstValue; d != nil {
+		d.push(ctx, d.unary(arg, func(v interface{}) (interface{}, error) {
+			return EvalBuiltin(quad, v)
+		}), resultType)
+		return nil
+	}
+
+	result := ctx.NewTemp()
+	generateQuadruple(ctx, quad, arg, "", result)
+	PushOperand(ctx, result, resultType)
+	return nil
+}
@@ -0,0 +1,225 @@
+package semantic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"Patito/token"
+)
+
+// ConstDeclaration guarda una declaración `const` mientras se calcula su
+// valor. Mientras tanto las operaciones no generan cuádruplos: los operandos
+// de la pila son índices `#n` de values y cada operación calcula su
+// resultado.
+type ConstDeclaration struct {
+	Name string
+	Type Type
+	Pos  token.Pos
+
+	values []constValue
+	// notConstant indica que el valor usó variables, arreglos, records o
+	// llamadas, que sólo se conocen en ejecución
+	notConstant bool
+}
+
+// constValue es un valor intermedio del cálculo de una constante. err guarda
+// por qué no se pudo calcular (p. ej. una división entre cero) y sólo se
+// reporta si el valor se usa: en `false && 1 / 0 > 0` no se usa.
+type constValue struct {
+	value interface{} // nil si no se conoce en compilación
+	err   error
+}
+
+func (v constValue) known() bool {
+	return v.value != nil && v.err == nil
+}
+
+// push apila un valor calculado.
+func (d *ConstDeclaration) push(ctx *Context, v constValue, t Type) {
+	d.values = append(d.values, v)
+	PushOperand(ctx, fmt.Sprintf("#%d", len(d.values)-1), t)
+}
+
+// valueOf devuelve el valor de un operando; los que no son `#n` (variables o
+// errores ya reportados) no se conocen.
+func (d *ConstDeclaration) valueOf(operand string) constValue {
+	if !strings.HasPrefix(operand, "#") {
+		return constValue{}
+	}
+	i, err := strconv.Atoi(operand[1:])
+	if err != nil || i < 0 || i >= len(d.values) {
+		return constValue{}
+	}
+	return d.values[i]
+}
+
+// unary calcula eval sobre el valor del operando; un operando desconocido o
+// con error da ese mismo resultado.
+func (d *ConstDeclaration) unary(operand string, eval func(interface{}) (interface{}, error)) constValue {
+	v := d.valueOf(operand)
+	if !v.known() {
+		return v
+	}
+	value, err := eval(v.value)
+	return constValue{value: value, err: err}
+}
+
+// binary es unary con dos operandos.
+func (d *ConstDeclaration) binary(left, right string, eval func(l, r interface{}) (interface{}, error)) constValue {
+	l, r := d.valueOf(left), d.valueOf(right)
+	if !l.known() {
+		return l
+	}
+	if !r.known() {
+		return r
+	}
+	value, err := eval(l.value, r.value)
+	return constValue{value: value, err: err}
+}
+
+// logical calcula `&&` o `||` con corto circuito: si el izquierdo decide el
+// resultado, el derecho no se usa.
+func (d *ConstDeclaration) logical(op, left, right string) constValue {
+	l := d.valueOf(left)
+	if !l.known() {
+		return l
+	}
+	if b, ok := l.value.(bool); ok && b == (op == string(OpOr)) {
+		return l
+	}
+	return d.valueOf(right)
+}
+
+// markNotConstant anota que el valor de la constante en curso usa algo que
+// sólo se conoce en ejecución. Regresa false si no se está calculando una
+// constante.
+func markNotConstant(ctx *Context) bool {
+	if ctx.ConstValue == nil {
+		return false
+	}
+	ctx.ConstValue.notConstant = true
+	return true
+}
+
+// FoldCall atiende una llamada dentro del valor de una constante: en lugar de
+// generar ERA, PARAM y GOSUB apila un valor desconocido del tipo de retorno
+// para que ProcessConstValue reporte que no es constante. Regresa false
+// fuera de una constante.
+func FoldCall(ctx *Context, returnType Type) bool {
+	if !markNotConstant(ctx) {
+		return false
+	}
+	if returnType != TypeVoid {
+		ctx.ConstValue.push(ctx, constValue{}, returnType)
+	}
+	return true
+}
+
+// LookupConstant busca una constante visible en el punto actual. Los nombres
+// se resuelven como los de variables, del scope más interno hacia el global:
+// una variable o parámetro con el mismo nombre oculta a la constante.
+func LookupConstant(ctx *Context, name string) (*Symbol, bool) {
+	sym, ok := ctx.Lookup(name)
+	if !ok || !sym.Constant {
+		return nil, false
+	}
+	return sym, true
+}
+
+// checkNotConstant reporta el uso de una constante como destino de una
+// asignación, de read o de la variable de control de un for.
+func checkNotConstant(ctx *Context, name string, pos token.Pos) bool {
+	if _, ok := LookupConstant(ctx, name); !ok {
+		return true
+	}
+	ctx.Report(NewDiagnostic(CodeConstantAssignment, pos, len(name), "no se puede modificar la constante '%s'", name))
+	return false
+}
+
+// CheckConstantConflict reporta una variable que usa el nombre de una
+// constante del mismo scope y regresa true si lo hizo; en un scope interno
+// el nombre oculta a la constante.
+func CheckConstantConflict(ctx *Context, name string, pos token.Pos) bool {
+	scope := ctx.CurrentScope()
+	first, ok := scope.symbols[name]
+	if !ok || !first.Constant {
+		return false
+	}
+	ctx.Report(&DuplicateSymbolError{Name: name, Scope: scope.Kind, FirstPos: first.Pos, SecondPos: pos})
+	return true
+}
+
+// ProcessConstStart procesa `const nombre: tipo` antes de su valor; a partir
+// de aquí las expresiones se calculan en compilación (ver ConstDeclaration).
+// El nombre debe estar libre en el scope actual.
+func ProcessConstStart(ctx *Context, name string, constType Type, pos token.Pos) *ConstDeclaration {
+	scope := ctx.CurrentScope()
+	if first, ok := scope.symbols[name]; ok {
+		ctx.Report(&DuplicateSymbolError{Name: name, Scope: scope.Kind, FirstPos: first.Pos, SecondPos: pos})
+	}
+	decl := &ConstDeclaration{Name: name, Type: constType, Pos: pos}
+	ctx.ConstValue = decl
+	return decl
+}
+
+// ProcessConstValue desapila el valor calculado de la declaración, valida su
+// tipo y que sólo use literales, otras constantes y funciones predefinidas, y
+// registra la constante. valuePos es la posición de la expresión.
+func ProcessConstValue(ctx *Context, decl *ConstDeclaration, valuePos token.Pos) error {
+	err := ProcessExpressionEnd(ctx)
+	ctx.ConstValue = nil
+	if err != nil {
+		return err
+	}
+	result, popped := ctx.OperandStack.Pop()
+	if !popped {
+		return internalError("no hay valor para la constante '%s'", decl.Name)
+	}
+	valueType, _ := ctx.TypeStack.Pop()
+
+	if valueType == TypeInvalid || decl.Type == TypeInvalid {
+		defineConstant(ctx, decl, nil)
+		return nil
+	}
+	if _, err := ctx.Cube.Result(OpAssign, decl.Type, valueType); err != nil {
+		ctx.Report(NewDiagnostic(CodeAssignmentMismatch, decl.Pos, len(decl.Name),
+			"no se puede asignar un valor %s a la constante '%s' de tipo %s", valueType, decl.Name, decl.Type))
+		defineConstant(ctx, decl, nil)
+		return nil
+	}
+
+	value := decl.valueOf(result)
+	switch {
+	case decl.notConstant || (value.value == nil && value.err == nil):
+		ctx.Report(NewDiagnostic(CodeNotConstant, valuePos, 1,
+			"el valor de la constante '%s' debe calcularse en compilación: sólo puede usar literales y otras constantes", decl.Name))
+		defineConstant(ctx, decl, nil)
+	case value.err != nil:
+		ctx.Report(NewDiagnostic(CodeNotConstant, valuePos, 1,
+			"no se pudo calcular la constante '%s': %s", decl.Name, value.err))
+		defineConstant(ctx, decl, nil)
+	default:
+		defineConstant(ctx, decl, CoerceValue(value.value, decl.Type))
+	}
+	return nil
+}
+
+// defineConstant registra la constante en el scope actual con su valor, que
+// queda en la tabla de constantes. Con value == nil se registra sin dirección
+// para no reportar después usos de una constante no declarada. Si el nombre
+// ya estaba en el scope, ProcessConstStart lo reportó y se conserva la
+// primera declaración.
+func defineConstant(ctx *Context, decl *ConstDeclaration, value interface{}) {
+	if _, exists := ctx.CurrentScope().symbols[decl.Name]; exists {
+		return
+	}
+	sym := &Symbol{Name: decl.Name, Type: decl.Type, Pos: decl.Pos, Constant: true}
+	if value != nil {
+		sym.Value = value
+		sym.Address = constantAddress(ctx, FormatValue(value), decl.Type)
+	} else {
+		sym.Type = TypeInvalid
+	}
+	_ = ctx.declare(sym) // el nombre está libre
+}
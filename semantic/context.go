@@ -1,6 +1,10 @@
 package semantic
 
-import "Patito/token"
+import (
+	"errors"
+
+	"Patito/token"
+)
 
 // Context es el objeto que asignamos a parser.Context para compartir estado
 // entre las acciones semánticas.
@@ -15,10 +19,13 @@ type Context struct {
 	TempCounter    *TempCounter
 	AddressManager *VirtualAddressManager
 	ConstantTable  *ConstantTable
-	// VariableTypes almacena tipos de variables mientras se procesan (antes de agregar al directorio)
-	VariableTypes map[string]Type
-	// VariableAddresses almacena direcciones virtuales de variables mientras se procesan
-	VariableAddresses map[string]int
+	// Scopes es la pila de scopes con las variables y constantes visibles
+	// mientras se parsea: la global en el fondo, luego la función y los
+	// bloques abiertos
+	Scopes []*Scope
+	// ConstValue es la declaración const cuyo valor se está calculando; nil
+	// fuera de ellas
+	ConstValue *ConstDeclaration
 	// FunctionStack tracks the function currently being processed for return statement validation
 	// We use a stack to handle nested scopes (if needed in the future)
 	FunctionStack []*FunctionEntry
@@ -32,13 +39,34 @@ type Context struct {
 	// This is used as a workaround for bottom-up parsing where body is processed before reduceFunction
 	PendingFunctionName string
 	PendingReturns      []PendingReturn
-	FunctionStartQuads  map[string]int
+	FunctionStartQuads  map[*FunctionEntry]int
+	// Calls son los ERA y GOSUB generados; ResolveFunctionSymbols les pone
+	// el símbolo definitivo de la función al terminar el programa
+	Calls []CallSite
 	// ProgramStartGotoIndex stores the index of the GOTO quadruple at program start
 	// This will be filled when the main function body starts
 	ProgramStartGotoIndex int
 	// MainStartIndex tracks when main body actually starts (for filling GOTO)
 	MainStartIndex       int
 	LastFunctionEndIndex int
+	// Loops es la pila de ciclos abiertos, con los saltos de break/continue
+	// que se completan al cerrarlos
+	Loops []*Loop
+	// Switches es la pila de switch abiertos
+	Switches []*Switch
+	// Diagnostics acumula los errores reportados durante el parseo; el análisis
+	// continúa para reportar todos los problemas en una sola compilación.
+	Diagnostics DiagnosticList
+	// exhausted son los segmentos que ya se reportaron sin direcciones
+	exhausted map[Segment]bool
+}
+
+// Loop guarda los saltos pendientes de un ciclo mientras se compila su cuerpo.
+type Loop struct {
+	ContinueTarget int   // índice al que salta continue; -1 si todavía no se conoce
+	Breaks         []int // GOTOs de break, apuntan al final del ciclo
+	Continues      []int // GOTOs de continue emitidos antes de conocer ContinueTarget
+	Switch         bool  // es un switch: acepta break pero continue lo atraviesa
 }
 
 type PendingReturn struct {
@@ -88,10 +116,9 @@ func NewContext() *Context {
 		TempCounter:           tempCounter,
 		AddressManager:        addressManager,
 		ConstantTable:         NewConstantTable(),
-		VariableTypes:         make(map[string]Type),
-		VariableAddresses:     make(map[string]int),
+		Scopes:                []*Scope{{Kind: ScopeGlobal, symbols: make(map[string]*Symbol)}},
 		PendingReturns:        make([]PendingReturn, 0),
-		FunctionStartQuads:    make(map[string]int),
+		FunctionStartQuads:    make(map[*FunctionEntry]int),
 		ProgramStartGotoIndex: -1,
 		MainStartIndex:        -1,
 	}
@@ -103,3 +130,51 @@ func NewContext() *Context {
 func (c *Context) DirectorySnapshot() *FunctionDirectory {
 	return c.Directory
 }
+
+// Report registra un error y permite que el análisis continúe.
+// Un segmento agotado se reporta una sola vez aunque fallen todas las
+// asignaciones que siguen.
+func (c *Context) Report(err error) {
+	if err == nil {
+		return
+	}
+	var overflow *SegmentOverflowError
+	if errors.As(err, &overflow) {
+		if c.exhausted[overflow.Segment] {
+			return
+		}
+		if c.exhausted == nil {
+			c.exhausted = make(map[Segment]bool)
+		}
+		c.exhausted[overflow.Segment] = true
+	}
+	c.Diagnostics = append(c.Diagnostics, AsDiagnostic(err))
+}
+
+// NewTemp reserva un temporal y devuelve su dirección como operando. Si el
+// segmento temporal se agotó lo reporta y devuelve la dirección 0: el
+// programa ya no compila.
+func (c *Context) NewTemp() string {
+	temp, err := c.TempCounter.NextString()
+	c.Report(err)
+	return temp
+}
+
+// HasErrors indica si se reportó al menos un error.
+func (c *Context) HasErrors() bool {
+	for _, d := range c.Diagnostics {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// ResetExpressionStacks descarta operandos y operadores pendientes. Se usa al
+// recuperarse de un error de sintaxis a mitad de una expresión.
+func (c *Context) ResetExpressionStacks() {
+	c.ConstValue = nil
+	c.OpStack = NewOperatorStack()
+	c.OperandStack = NewOperandStack()
+	c.TypeStack = NewTypeStack()
+}
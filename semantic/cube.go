@@ -1,9 +1,7 @@
 package semantic
 
-import "fmt"
-
-// Operator representa las operaciones binarias del lenguaje que necesitan
-// validación semántica (aritméticas, relacionales y asignaciones).
+// Operator representa las operaciones del lenguaje que necesitan validación
+// semántica (aritméticas, relacionales, asignaciones y funciones predefinidas).
 type Operator string
 
 const (
@@ -11,13 +9,30 @@ const (
 	OpSub      Operator = "-"
 	OpMul      Operator = "*"
 	OpDiv      Operator = "/"
+	OpMod      Operator = "%"
 	OpGt       Operator = ">"
 	OpLt       Operator = "<"
+	OpGte      Operator = ">="
+	OpLte      Operator = "<="
 	OpNeq      Operator = "!="
 	OpEq       Operator = "=="
+	OpAnd      Operator = "&&"
+	OpOr       Operator = "||"
 	OpAssign   Operator = "="
 	OpUnaryPos Operator = "u+"
 	OpUnaryNeg Operator = "u-"
+	OpNot      Operator = "!"
+
+	// Funciones predefinidas; se validan como operaciones unarias.
+	OpLen     Operator = "len"
+	OpOrd     Operator = "ord"
+	OpChr     Operator = "chr"
+	OpToInt   Operator = "int"
+	OpToFloat Operator = "float"
+	OpRound   Operator = "round"
+	OpFloor   Operator = "floor"
+	OpCeil    Operator = "ceil"
+	OpAbs     Operator = "abs"
 )
 
 // SemanticCube codifica la tabla de compatibilidades (cubo semántico).
@@ -50,7 +65,7 @@ func (c *SemanticCube) Result(op Operator, left, right Type) (Type, error) {
 			}
 		}
 	}
-	return TypeInvalid, fmt.Errorf("operación inválida: %s (%s, %s)", op, left, right)
+	return TypeInvalid, &InvalidOperationError{Op: op, Left: left, Right: right}
 }
 
 // ResultUnary evalúa operaciones unarias (+x, -x). Internamente modelamos la
@@ -64,6 +79,7 @@ var DefaultSemanticCube = func() *SemanticCube {
 	cube := NewSemanticCube()
 
 	// --- Aritmética binaria ---
+	// bool no tiene entradas: cualquier operación aritmética con booleanos es inválida.
 	arithOps := []Operator{OpAdd, OpSub, OpMul, OpDiv}
 	for _, op := range arithOps {
 		cube.Set(op, TypeInt, TypeInt, TypeInt)
@@ -71,6 +87,10 @@ var DefaultSemanticCube = func() *SemanticCube {
 		cube.Set(op, TypeFloat, TypeInt, TypeFloat)
 		cube.Set(op, TypeFloat, TypeFloat, TypeFloat)
 	}
+	// El módulo sólo está definido entre enteros.
+	cube.Set(OpMod, TypeInt, TypeInt, TypeInt)
+	// + entre strings es concatenación; no hay conversión implícita de números.
+	cube.Set(OpAdd, TypeString, TypeString, TypeString)
 
 	// --- Aritmética unaria ---
 	cube.Set(OpUnaryPos, TypeInt, TypeInvalid, TypeInt)
@@ -78,21 +98,52 @@ var DefaultSemanticCube = func() *SemanticCube {
 	cube.Set(OpUnaryNeg, TypeInt, TypeInvalid, TypeInt)
 	cube.Set(OpUnaryNeg, TypeFloat, TypeInvalid, TypeFloat)
 
-	// --- Relacionales (> < != ==) ---
-	relOps := []Operator{OpGt, OpLt, OpNeq, OpEq}
+	// --- Relacionales (> < >= <= != ==) ---
+	relOps := []Operator{OpGt, OpLt, OpGte, OpLte, OpNeq, OpEq}
 	for _, op := range relOps {
 		cube.Set(op, TypeInt, TypeInt, TypeBool)
 		cube.Set(op, TypeFloat, TypeFloat, TypeBool)
 		cube.Set(op, TypeInt, TypeFloat, TypeBool)
 		cube.Set(op, TypeFloat, TypeInt, TypeBool)
 	}
+	// Los char se comparan por su código Unicode.
+	for _, op := range relOps {
+		cube.Set(op, TypeChar, TypeChar, TypeBool)
+	}
+	// La igualdad también aplica entre booleanos y entre strings; el orden (> < >= <=) no.
+	cube.Set(OpEq, TypeBool, TypeBool, TypeBool)
+	cube.Set(OpNeq, TypeBool, TypeBool, TypeBool)
+	cube.Set(OpEq, TypeString, TypeString, TypeBool)
+	cube.Set(OpNeq, TypeString, TypeString, TypeBool)
+
+	// --- Lógicos (&& || !) ---
+	cube.Set(OpAnd, TypeBool, TypeBool, TypeBool)
+	cube.Set(OpOr, TypeBool, TypeBool, TypeBool)
+	cube.Set(OpNot, TypeBool, TypeInvalid, TypeBool)
+
+	// --- Funciones predefinidas ---
+	cube.Set(OpLen, TypeString, TypeInvalid, TypeInt)
+	cube.Set(OpOrd, TypeChar, TypeInvalid, TypeInt)
+	cube.Set(OpChr, TypeInt, TypeInvalid, TypeChar)
+	// int() trunca hacia cero; round/floor/ceil también regresan int.
+	for _, op := range []Operator{OpToInt, OpRound, OpFloor, OpCeil} {
+		cube.Set(op, TypeInt, TypeInvalid, TypeInt)
+		cube.Set(op, TypeFloat, TypeInvalid, TypeInt)
+	}
+	cube.Set(OpToFloat, TypeInt, TypeInvalid, TypeFloat)
+	cube.Set(OpToFloat, TypeFloat, TypeInvalid, TypeFloat)
+	cube.Set(OpAbs, TypeInt, TypeInvalid, TypeInt)
+	cube.Set(OpAbs, TypeFloat, TypeInvalid, TypeFloat)
 
 	// --- Asignaciones ---
-	// Se permite asignar int->int, float->float, int->float (promoción) y bool->bool.
+	// Se permite asignar int->int, float->float, int->float (promoción), bool->bool
+	// string->string y char->char.
 	cube.Set(OpAssign, TypeInt, TypeInt, TypeInt)
 	cube.Set(OpAssign, TypeFloat, TypeFloat, TypeFloat)
 	cube.Set(OpAssign, TypeFloat, TypeInt, TypeFloat) // promoción
 	cube.Set(OpAssign, TypeBool, TypeBool, TypeBool)
+	cube.Set(OpAssign, TypeString, TypeString, TypeString)
+	cube.Set(OpAssign, TypeChar, TypeChar, TypeChar)
 
 	return cube
 }()
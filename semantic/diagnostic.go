@@ -0,0 +1,263 @@
+package semantic
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"Patito/token"
+)
+
+// Severity indica qué tan grave es un diagnóstico.
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+	SeverityNote
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityNote:
+		return "note"
+	default:
+		return "desconocido"
+	}
+}
+
+// Code identifica la clase de un diagnóstico de forma estable (útil para
+// pruebas y para documentar errores comunes).
+type Code string
+
+const (
+	CodeSyntax               Code = "E0001" // error léxico o sintáctico
+	CodeInternal             Code = "E0002" // estado inconsistente del compilador
+	CodeUndeclaredVariable   Code = "E0100"
+	CodeUndeclaredFunction   Code = "E0101"
+	CodeDuplicateSymbol      Code = "E0102"
+	CodeFunctionRedefinition Code = "E0103"
+	CodeProgramRedefinition  Code = "E0104"
+	CodeUndeclaredType       Code = "E0105" // tipo record no declarado
+	CodeUndefinedFunction    Code = "E0106" // prototipo sin definición
+	CodePrototypeMismatch    Code = "E0107" // definición distinta a su prototipo
+	CodeInvalidOperation     Code = "E0200" // el cubo semántico rechaza la operación
+	CodeAssignmentMismatch   Code = "E0201"
+	CodeArgumentCount        Code = "E0202"
+	CodeArgumentType         Code = "E0203"
+	CodeReturnType           Code = "E0204"
+	CodeMissingReturn        Code = "E0205"
+	CodeUnsupportedConstant  Code = "E0206"
+	CodeConditionType        Code = "E0207" // condición de if/while que no es bool
+	CodeIndexType            Code = "E0208" // índice de arreglo que no es int
+	CodeDimensionMismatch    Code = "E0209" // número de índices distinto a las dimensiones
+	CodeArraySize            Code = "E0210" // dimensión de tamaño cero o arreglo que no cabe en su segmento
+	CodeLoopType             Code = "E0211" // variable, límite o paso de for que no es int
+	CodeLoopControl          Code = "E0212" // break o continue fuera de un ciclo
+	CodeSwitchType           Code = "E0213" // la expresión de switch no es int
+	CodeDuplicateCase        Code = "E0214" // valor de case repetido en un switch
+	CodeConstantAssignment   Code = "E0215" // asignación, read o for sobre una constante
+	CodeNotConstant          Code = "E0216" // valor de const que no se puede calcular en compilación
+	CodeRecordField          Code = "E0217" // campo inexistente o record usado sin campo
+	CodeReferenceArgument    Code = "E0218" // argumento de un parámetro ref que no es variable
+	CodeNoMatchingOverload   Code = "E0219" // ninguna sobrecarga acepta los argumentos de la llamada
+	CodeAmbiguousCall        Code = "E0220" // varias sobrecargas aceptan los argumentos igual de bien
+	CodeSegmentOverflow      Code = "E0221" // el programa no cabe en un segmento de direcciones virtuales
+)
+
+// Diagnostic es un mensaje del compilador con severidad, código y el rango
+// de código fuente al que se refiere. Line == 0 indica que no hay posición.
+type Diagnostic struct {
+	Severity  Severity
+	Code      Code
+	File      string
+	Line      int
+	Column    int
+	EndLine   int
+	EndColumn int // exclusiva
+	Message   string
+}
+
+func (d *Diagnostic) Error() string {
+	var b strings.Builder
+	if d.File != "" {
+		b.WriteString(d.File)
+		b.WriteString(":")
+	}
+	if d.Line > 0 {
+		fmt.Fprintf(&b, "%d:%d: ", d.Line, d.Column)
+	} else if d.File != "" {
+		b.WriteString(" ")
+	}
+	fmt.Fprintf(&b, "%s[%s]: %s", d.Severity, d.Code, d.Message)
+	return b.String()
+}
+
+// DiagnosticList agrupa varios diagnósticos para regresarlos como un solo error.
+type DiagnosticList []*Diagnostic
+
+func (l DiagnosticList) Error() string {
+	lines := make([]string, len(l))
+	for i, d := range l {
+		lines[i] = d.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Unwrap permite usar errors.As para obtener el primer *Diagnostic.
+func (l DiagnosticList) Unwrap() []error {
+	errs := make([]error, len(l))
+	for i, d := range l {
+		errs[i] = d
+	}
+	return errs
+}
+
+// Sort ordena los diagnósticos por posición; los que no tienen posición van al final.
+func (l DiagnosticList) Sort() {
+	sort.SliceStable(l, func(i, j int) bool {
+		a, b := l[i], l[j]
+		if a.HasPosition() != b.HasPosition() {
+			return a.HasPosition()
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+}
+
+// HasPosition indica si el diagnóstico apunta a una ubicación del fuente.
+func (d *Diagnostic) HasPosition() bool {
+	return d.Line > 0
+}
+
+// SetPos fija el inicio del diagnóstico y un rango de `length` columnas.
+func (d *Diagnostic) SetPos(pos token.Pos, length int) {
+	if length < 1 {
+		length = 1
+	}
+	d.Line = pos.Line
+	d.Column = pos.Column
+	d.EndLine = pos.Line
+	d.EndColumn = pos.Column + length
+	if src, ok := pos.Context.(token.Sourcer); ok {
+		d.File = src.Source()
+	}
+}
+
+// NewDiagnostic crea un error posicionado en pos que abarca `length` columnas.
+func NewDiagnostic(code Code, pos token.Pos, length int, format string, args ...interface{}) *Diagnostic {
+	d := &Diagnostic{
+		Severity: SeverityError,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	if pos.Line > 0 {
+		d.SetPos(pos, length)
+	}
+	return d
+}
+
+// DiagnosticAt crea un error que subraya el token completo.
+func DiagnosticAt(code Code, tok *token.Token, format string, args ...interface{}) *Diagnostic {
+	return NewDiagnostic(code, tok.Pos, utf8.RuneCount(tok.Lit), format, args...)
+}
+
+// internalError reporta una inconsistencia del compilador (pilas vacías, etc.).
+func internalError(format string, args ...interface{}) *Diagnostic {
+	return NewDiagnostic(CodeInternal, token.Pos{}, 0, "error interno: "+format, args...)
+}
+
+// AsDiagnostic convierte cualquier error semántico a *Diagnostic. Los errores
+// tipados del directorio conservan su posición; los desconocidos se reportan
+// como internos sin posición.
+func AsDiagnostic(err error) *Diagnostic {
+	if err == nil {
+		return nil
+	}
+	var diag *Diagnostic
+	if errors.As(err, &diag) {
+		return diag
+	}
+
+	var dup *DuplicateSymbolError
+	var redef *FunctionRedefinitionError
+	var prog *ProgramRedefinitionError
+	var mismatch *PrototypeMismatchError
+	var invalid *InvalidOperationError
+	var overflow *SegmentOverflowError
+	switch {
+	case errors.As(err, &dup):
+		return NewDiagnostic(CodeDuplicateSymbol, dup.SecondPos, len(dup.Name), "%s", dup.Error())
+	case errors.As(err, &redef):
+		return NewDiagnostic(CodeFunctionRedefinition, redef.RedeclaredAt, len(redef.Name), "%s", redef.Error())
+	case errors.As(err, &prog):
+		return NewDiagnostic(CodeProgramRedefinition, prog.RedeclaredAt, len(prog.Name), "%s", prog.Error())
+	case errors.As(err, &mismatch):
+		return NewDiagnostic(CodePrototypeMismatch, mismatch.DefinedAt, len(mismatch.Name), "%s", mismatch.Error())
+	case errors.As(err, &overflow):
+		return NewDiagnostic(CodeSegmentOverflow, token.Pos{}, 0, "%s", overflow.Error())
+	case errors.As(err, &invalid):
+		return NewDiagnostic(CodeInvalidOperation, token.Pos{}, 0, "%s", invalid.Error())
+	default:
+		return NewDiagnostic(CodeInternal, token.Pos{}, 0, "%s", err.Error())
+	}
+}
+
+// RenderDiagnostic escribe el diagnóstico seguido de la línea del fuente
+// involucrada con el rango subrayado:
+//
+//	prog.patito:3:5: error[E0100]: variable 'x' no declarada
+//	   3 |     x = 1;
+//	     |     ^
+func RenderDiagnostic(w io.Writer, d *Diagnostic, src []byte) error {
+	if _, err := fmt.Fprintln(w, d.Error()); err != nil {
+		return err
+	}
+	if !d.HasPosition() {
+		return nil
+	}
+
+	lines := strings.Split(string(src), "\n")
+	if d.Line > len(lines) {
+		return nil
+	}
+	line := strings.TrimRight(lines[d.Line-1], "\r")
+
+	// Respetar tabuladores del fuente para que el subrayado quede alineado.
+	// El lexer de gocc cuenta cada tabulador como 4 columnas.
+	var prefix strings.Builder
+	col := 1
+	for _, r := range line {
+		if col >= d.Column {
+			break
+		}
+		if r == '\t' {
+			prefix.WriteRune('\t')
+			col += 4
+		} else {
+			prefix.WriteRune(' ')
+			col++
+		}
+	}
+
+	width := 1
+	if d.EndLine == d.Line && d.EndColumn > d.Column {
+		width = d.EndColumn - d.Column
+	}
+
+	gutter := fmt.Sprintf("%4d", d.Line)
+	if _, err := fmt.Fprintf(w, "%s | %s\n", gutter, line); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%s | %s%s\n", strings.Repeat(" ", len(gutter)), prefix.String(), strings.Repeat("^", width))
+	return err
+}
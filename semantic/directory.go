@@ -13,6 +13,7 @@ const (
 	ScopeGlobal ScopeKind = iota
 	ScopeLocal
 	ScopeParam
+	ScopeBlock
 )
 
 func (s ScopeKind) String() string {
@@ -23,6 +24,8 @@ func (s ScopeKind) String() string {
 		return "local"
 	case ScopeParam:
 		return "parámetros"
+	case ScopeBlock:
+		return "bloque"
 	default:
 		return "desconocido"
 	}
@@ -34,7 +37,10 @@ type VariableSpec struct {
 	Name    string
 	Type    Type
 	Pos     token.Pos
-	Address int // Dirección virtual asignada
+	Address int         // Dirección virtual asignada
+	Dims    []int       // Tamaño de cada dimensión; nil para escalares
+	Record  *RecordType // Tipo record si Type es TypeRecord
+	ByRef   bool        // Parámetro `ref`: recibe la dirección del argumento
 }
 
 // VariableEntry es el elemento almacenado en una tabla después de validar duplicados.
@@ -43,7 +49,27 @@ type VariableEntry struct {
 	Type       Type
 	Scope      ScopeKind
 	DeclaredAt token.Pos
-	Address    int // Dirección virtual asignada
+	Address    int         // Dirección virtual asignada (la del primer elemento en arreglos)
+	Dims       []int       // Tamaño de cada dimensión; nil para escalares
+	Record     *RecordType // Tipo record si Type es TypeRecord
+	ByRef      bool        // Parámetro por referencia
+}
+
+// Size devuelve cuántas celdas de memoria ocupa la variable.
+func (e *VariableEntry) Size() int {
+	if e.Record != nil {
+		return e.Record.Size
+	}
+	return ArraySize(e.Dims)
+}
+
+// CellType devuelve el tipo de la celda i de la variable: el del campo en
+// un record y el de la variable en escalares y arreglos.
+func (e *VariableEntry) CellType(i int) Type {
+	if e.Record != nil {
+		return e.Record.Fields[i].Type
+	}
+	return e.Type
 }
 
 // VariableTable mantiene las variables de un determinado scope.
@@ -79,6 +105,9 @@ func (vt *VariableTable) Add(spec *VariableSpec) error {
 		Scope:      vt.kind,
 		DeclaredAt: spec.Pos,
 		Address:    spec.Address, // Dirección virtual asignada
+		Dims:       spec.Dims,
+		Record:     spec.Record,
+		ByRef:      spec.ByRef,
 	}
 	vt.entries[spec.Name] = entry
 	vt.order = append(vt.order, entry)
@@ -107,13 +136,17 @@ func (vt *VariableTable) Entries() []*VariableEntry {
 
 // FunctionEntry representa una función registrada en el directorio.
 type FunctionEntry struct {
-	Name       string
+	Name string
+	// Symbol identifica a la función en los cuádruplos y en el .patitoc: es
+	// su nombre o, si el nombre está sobrecargado, su firma (ver Mangle)
+	Symbol     string
 	ReturnType Type
 	DeclaredAt token.Pos
 
 	Params    *VariableTable
 	Locals    *VariableTable
 	Finalized bool
+	Forward   bool // declarada con un prototipo y todavía sin cuerpo
 }
 
 // FunctionDirectory centraliza la tabla de funciones y la tabla global.
@@ -121,14 +154,21 @@ type FunctionDirectory struct {
 	ProgramName string
 	ProgramPos  token.Pos
 
-	Globals   *VariableTable
-	Functions map[string]*FunctionEntry
+	Globals *VariableTable
+	// Functions agrupa las sobrecargas de cada nombre en orden de declaración
+	Functions map[string][]*FunctionEntry
+	// functionOrder conserva el orden de declaración para recorridos deterministas.
+	functionOrder []*FunctionEntry
+	// Records guarda los tipos record declarados con `type`
+	Records     map[string]*RecordType
+	recordOrder []*RecordType
 }
 
 func NewFunctionDirectory() *FunctionDirectory {
 	return &FunctionDirectory{
 		Globals:   NewVariableTable(ScopeGlobal),
-		Functions: make(map[string]*FunctionEntry),
+		Functions: make(map[string][]*FunctionEntry),
+		Records:   make(map[string]*RecordType),
 	}
 }
 
@@ -153,14 +193,18 @@ func (fd *FunctionDirectory) AddGlobals(specs []*VariableSpec, addressManager *V
 	// Asignar direcciones virtuales a variables globales
 	for _, spec := range specs {
 		if spec.Address == 0 { // Si no tiene dirección asignada
-			spec.Address = addressManager.NextGlobal()
+			addr, err := addressManager.NextGlobal()
+			if err != nil {
+				return err
+			}
+			spec.Address = addr
 		}
 	}
 	return fd.Globals.AddMany(specs)
 }
 
 func (fd *FunctionDirectory) AddFunction(name string, returnType Type, pos token.Pos, params []*VariableSpec, locals []*VariableSpec, addressManager *VirtualAddressManager) (*FunctionEntry, error) {
-	if existing, ok := fd.Functions[name]; ok {
+	if existing := fd.overload(name, params); existing != nil {
 		return nil, &FunctionRedefinitionError{
 			Name:         name,
 			ExistingPos:  existing.DeclaredAt,
@@ -174,7 +218,11 @@ func (fd *FunctionDirectory) AddFunction(name string, returnType Type, pos token
 	// Asignar direcciones virtuales a parámetros
 	for _, spec := range params {
 		if spec.Address == 0 { // Si no tiene dirección asignada
-			spec.Address = addressManager.NextLocal()
+			addr, err := addressManager.NextLocal()
+			if err != nil {
+				return nil, err
+			}
+			spec.Address = addr
 		}
 	}
 
@@ -187,7 +235,11 @@ func (fd *FunctionDirectory) AddFunction(name string, returnType Type, pos token
 	// Asignar direcciones virtuales a variables locales
 	for _, spec := range locals {
 		if spec.Address == 0 { // Si no tiene dirección asignada
-			spec.Address = addressManager.NextLocal()
+			addr, err := addressManager.NextLocal()
+			if err != nil {
+				return nil, err
+			}
+			spec.Address = addr
 		}
 		if paramTable.Has(spec.Name) {
 			return nil, &DuplicateSymbolError{
@@ -204,18 +256,19 @@ func (fd *FunctionDirectory) AddFunction(name string, returnType Type, pos token
 
 	fn := &FunctionEntry{
 		Name:       name,
+		Symbol:     name,
 		ReturnType: returnType,
 		DeclaredAt: pos,
 		Params:     paramTable,
 		Locals:     localTable,
 	}
 
-	fd.Functions[name] = fn
+	fd.addFunction(fn)
 	return fn, nil
 }
 
 func (fd *FunctionDirectory) AddFunctionPrototype(name string, returnType Type, pos token.Pos, params []*VariableSpec, addressManager *VirtualAddressManager) (*FunctionEntry, error) {
-	if existing, ok := fd.Functions[name]; ok {
+	if existing := fd.overload(name, params); existing != nil {
 		return nil, &FunctionRedefinitionError{
 			Name:         name,
 			ExistingPos:  existing.DeclaredAt,
@@ -229,7 +282,11 @@ func (fd *FunctionDirectory) AddFunctionPrototype(name string, returnType Type,
 	// Asignar virutales a parametros
 	for _, spec := range params {
 		if spec.Address == 0 {
-			spec.Address = addressManager.NextLocal()
+			addr, err := addressManager.NextLocal()
+			if err != nil {
+				return nil, err
+			}
+			spec.Address = addr
 		}
 	}
 
@@ -240,6 +297,7 @@ func (fd *FunctionDirectory) AddFunctionPrototype(name string, returnType Type,
 
 	fn := &FunctionEntry{
 		Name:       name,
+		Symbol:     name,
 		ReturnType: returnType,
 		DeclaredAt: pos,
 		Params:     paramTable,
@@ -247,11 +305,96 @@ func (fd *FunctionDirectory) AddFunctionPrototype(name string, returnType Type,
 		Finalized:  false,
 	}
 
-	fd.Functions[name] = fn
+	fd.addFunction(fn)
 	return fn, nil
 
 }
 
+// DeclareFunction registra el prototipo `tipo nombre(params);` de una función
+// que se define más adelante, para poder llamarla antes de su definición.
+func (fd *FunctionDirectory) DeclareFunction(name string, returnType Type, pos token.Pos, params []*VariableSpec, addressManager *VirtualAddressManager) (*FunctionEntry, error) {
+	fn, err := fd.AddFunctionPrototype(name, returnType, pos, params, addressManager)
+	if err != nil {
+		return nil, err
+	}
+	fn.Forward = true
+	return fn, nil
+}
+
+// DefineFunction registra el encabezado de una definición. Si la función
+// tenía prototipo con los mismos tipos de parámetros, valida que coincidan y
+// completa esa misma entrada con los parámetros de la definición; si no,
+// equivale a AddFunctionPrototype (con otros tipos es otra sobrecarga).
+func (fd *FunctionDirectory) DefineFunction(name string, returnType Type, pos token.Pos, params []*VariableSpec, addressManager *VirtualAddressManager) (*FunctionEntry, error) {
+	fn := fd.overload(name, params)
+	if fn == nil || !fn.Forward {
+		return fd.AddFunctionPrototype(name, returnType, pos, params, addressManager)
+	}
+
+	// Con o sin error, el prototipo ya tiene definición: no se reporta
+	// además como función sin cuerpo
+	fn.Forward = false
+	if detail := prototypeMismatch(fn, returnType, params); detail != "" {
+		return nil, &PrototypeMismatchError{Name: name, Detail: detail, PrototypePos: fn.DeclaredAt, DefinedAt: pos}
+	}
+
+	addressManager.ResetLocals()
+	for _, spec := range params {
+		if spec.Address == 0 {
+			addr, err := addressManager.NextLocal()
+			if err != nil {
+				return nil, err
+			}
+			spec.Address = addr
+		}
+	}
+	paramTable := NewVariableTable(ScopeParam)
+	if err := paramTable.AddMany(params); err != nil {
+		return nil, err
+	}
+
+	fn.Params = paramTable
+	fn.DeclaredAt = pos
+	return fn, nil
+}
+
+// prototypeMismatch describe la primera diferencia entre un prototipo y su
+// definición ("" si coinciden). Los nombres de los parámetros pueden cambiar.
+func prototypeMismatch(fn *FunctionEntry, returnType Type, params []*VariableSpec) string {
+	if fn.ReturnType != returnType {
+		return fmt.Sprintf("regresa %s en lugar de %s", returnType, fn.ReturnType)
+	}
+	declared := fn.Params.Entries()
+	if len(declared) != len(params) {
+		return fmt.Sprintf("tiene %d parámetros en lugar de %d", len(params), len(declared))
+	}
+	for i, param := range declared {
+		if params[i].Type != param.Type || params[i].ByRef != param.ByRef {
+			return fmt.Sprintf("el parámetro %d es %s en lugar de %s", i+1, paramKind(params[i].Type, params[i].ByRef), paramKind(param.Type, param.ByRef))
+		}
+	}
+	return ""
+}
+
+func paramKind(t Type, byRef bool) string {
+	if byRef {
+		return "ref " + t.String()
+	}
+	return t.String()
+}
+
+// UndefinedFunctions devuelve, en orden de declaración, las funciones que
+// se declararon con prototipo y nunca se definieron.
+func (fd *FunctionDirectory) UndefinedFunctions() []*FunctionEntry {
+	var result []*FunctionEntry
+	for _, fn := range fd.functionOrder {
+		if fn.Forward {
+			result = append(result, fn)
+		}
+	}
+	return result
+}
+
 func (fd *FunctionDirectory) FinalizeFunction(fn *FunctionEntry, locals []*VariableSpec, addressManager *VirtualAddressManager) error {
 	if fn.Finalized {
 		return fmt.Errorf("funcion %s ya fue finalizada", fn.Name)
@@ -259,7 +402,11 @@ func (fd *FunctionDirectory) FinalizeFunction(fn *FunctionEntry, locals []*Varia
 
 	for _, spec := range locals {
 		if spec.Address == 0 {
-			spec.Address = addressManager.NextLocal()
+			addr, err := addressManager.NextLocal()
+			if err != nil {
+				return err
+			}
+			spec.Address = addr
 		}
 		if fn.Params.Has(spec.Name) {
 			return &DuplicateSymbolError{
@@ -278,93 +425,48 @@ func (fd *FunctionDirectory) FinalizeFunction(fn *FunctionEntry, locals []*Varia
 	return nil
 }
 
-func (fd *FunctionDirectory) GetFunction(name string) (*FunctionEntry, bool) {
-	fn, ok := fd.Functions[name]
-	return fn, ok
+// OrderedFunctions devuelve las funciones en orden de declaración.
+func (fd *FunctionDirectory) OrderedFunctions() []*FunctionEntry {
+	result := make([]*FunctionEntry, len(fd.functionOrder))
+	copy(result, fd.functionOrder)
+	return result
 }
 
-// GetVariableType busca una variable en el directorio y devuelve su tipo
-// Busca primero en globales, luego en función main (si existe)
-// Nota: En el cuerpo de main, las variables globales están disponibles
-func (fd *FunctionDirectory) GetVariableType(name string) (Type, error) {
-	// Buscar en globales primero (disponibles en todo el programa)
-	if entry, ok := fd.Globals.entries[name]; ok {
-		return entry.Type, nil
-	}
-
-	// Buscar en función main (si existe) - locales y parámetros
-	if mainFn, ok := fd.Functions["main"]; ok {
-		if entry, ok := mainFn.Locals.entries[name]; ok {
-			return entry.Type, nil
-		}
-		if entry, ok := mainFn.Params.entries[name]; ok {
-			return entry.Type, nil
+// GetFunction busca una función por su símbolo: su nombre si no está
+// sobrecargada. Los símbolos de las sobrecargas se asignan al terminar el
+// programa (ver AssignSymbols).
+func (fd *FunctionDirectory) GetFunction(symbol string) (*FunctionEntry, bool) {
+	for _, fn := range fd.functionOrder {
+		if fn.Symbol == symbol {
+			return fn, true
 		}
 	}
-
-	return TypeInvalid, fmt.Errorf("variable '%s' no declarada", name)
+	return nil, false
 }
 
-// GetVariableTypeFromContext busca una variable primero en el contexto (tipos temporales)
-// y luego en el directorio
+// GetVariableTypeFromContext busca una variable en la pila de scopes, del
+// más interno al global: sólo es visible lo declarado en el punto actual.
 func GetVariableTypeFromContext(ctx *Context, name string) (Type, error) {
-	// Buscar primero en VariableTypes (tipos temporales durante parsing)
-	if varType, ok := ctx.VariableTypes[name]; ok {
-		return varType, nil
+	if sym, ok := ctx.LookupVariable(name); ok {
+		return sym.Type, nil
 	}
-
-	// Buscar en el directorio
-	return ctx.Directory.GetVariableType(name)
+	return TypeInvalid, fmt.Errorf("variable '%s' no declarada", name)
 }
 
-// GetVariableAddressFromContext busca la dirección virtual de una variable
-func GetVariableAddressFromContext(ctx *Context, name string) (int, error) {
-	// Buscar primero en VariableAddresses (direcciones durante parsing)
-	if addr, ok := ctx.VariableAddresses[name]; ok && addr != 0 {
-		return addr, nil
+// GetVariableDimsFromContext devuelve las dimensiones de una variable (nil si
+// es escalar o no está declarada)
+func GetVariableDimsFromContext(ctx *Context, name string) []int {
+	if sym, ok := ctx.LookupVariable(name); ok {
+		return sym.Dims
 	}
-
-	// Si no está en VariableAddresses, verificar si la variable existe
-	// Si existe pero no tiene dirección asignada todavía, asignarla ahora
-	if _, ok := ctx.VariableTypes[name]; ok {
-		// Variable existe pero dirección no asignada todavía
-		// Asignar dirección temporalmente (será reemplazada cuando se agregue al directorio)
-		// Esto puede pasar si la variable se usa antes de que reduceProgram termine
-		// Por ahora, asignar una dirección global temporal
-		addr := ctx.AddressManager.NextGlobal()
-		ctx.VariableAddresses[name] = addr
-		return addr, nil
-	}
-
-	// Si no está en VariableAddresses ni en VariableTypes, buscar en el directorio
-	return ctx.Directory.GetVariableAddress(name)
+	return nil
 }
 
-// GetVariableAddress busca una variable en el directorio y devuelve su dirección virtual
-func (fd *FunctionDirectory) GetVariableAddress(name string) (int, error) {
-	// Buscar en globales primero
-	if entry, ok := fd.Globals.entries[name]; ok {
-		if entry.Address == 0 {
-			return 0, fmt.Errorf("variable '%s' tiene dirección 0 (no asignada)", name)
-		}
-		return entry.Address, nil
-	}
-
-	// Buscar en función main (si existe)
-	if mainFn, ok := fd.Functions["main"]; ok {
-		if entry, ok := mainFn.Locals.entries[name]; ok {
-			if entry.Address == 0 {
-				return 0, fmt.Errorf("variable '%s' tiene dirección 0 (no asignada)", name)
-			}
-			return entry.Address, nil
-		}
-		if entry, ok := mainFn.Params.entries[name]; ok {
-			if entry.Address == 0 {
-				return 0, fmt.Errorf("variable '%s' tiene dirección 0 (no asignada)", name)
-			}
-			return entry.Address, nil
-		}
+// GetVariableAddressFromContext busca la dirección virtual de una variable
+// como GetVariableTypeFromContext.
+func GetVariableAddressFromContext(ctx *Context, name string) (int, error) {
+	if sym, ok := ctx.LookupVariable(name); ok {
+		return sym.Address, nil
 	}
-
 	return 0, fmt.Errorf("variable '%s' no declarada", name)
 }
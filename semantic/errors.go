@@ -31,6 +31,20 @@ func (e *FunctionRedefinitionError) Error() string {
 		e.Name, e.ExistingPos, e.RedeclaredAt)
 }
 
+// PrototypeMismatchError indica que la definición de una función no coincide
+// con el prototipo que la declaró.
+type PrototypeMismatchError struct {
+	Name         string
+	Detail       string
+	PrototypePos token.Pos
+	DefinedAt    token.Pos
+}
+
+func (e *PrototypeMismatchError) Error() string {
+	return fmt.Sprintf("la definición de %q no coincide con su prototipo (%s): %s",
+		e.Name, e.PrototypePos, e.Detail)
+}
+
 // ProgramRedefinitionError protege el encabezado `program`.
 type ProgramRedefinitionError struct {
 	Name         string
@@ -43,3 +57,29 @@ func (e *ProgramRedefinitionError) Error() string {
 	return fmt.Sprintf("programa ya nombrado %q en %s; intento de renombrarlo a %q en %s",
 		e.Existing, e.ExistingPos, e.Name, e.RedeclaredAt)
 }
+
+// SegmentOverflowError indica que el programa necesita más direcciones
+// virtuales de las que tiene un segmento.
+type SegmentOverflowError struct {
+	Segment Segment
+}
+
+func (e *SegmentOverflowError) Error() string {
+	return fmt.Sprintf("se excedió el rango de direcciones del segmento %s", e.Segment)
+}
+
+// InvalidOperationError indica que el cubo semántico no tiene entrada para
+// aplicar Op sobre (Left, Right).
+type InvalidOperationError struct {
+	Op    Operator
+	Left  Type
+	Right Type
+}
+
+func (e *InvalidOperationError) Error() string {
+	if e.Right == TypeInvalid {
+		// Operación unaria
+		return fmt.Sprintf("operación inválida: %s (%s)", e.Op, e.Left)
+	}
+	return fmt.Sprintf("operación inválida: %s (%s, %s)", e.Op, e.Left, e.Right)
+}
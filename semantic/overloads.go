@@ -0,0 +1,187 @@
+package semantic
+
+import (
+	"fmt"
+	"strings"
+
+	"Patito/token"
+)
+
+// CallSite es un cuádruplo ERA o GOSUB cuyo primer operando es el símbolo de
+// la función llamada; se completa al terminar el programa, cuando ya se sabe
+// qué nombres están sobrecargados.
+type CallSite struct {
+	Index    int
+	Function *FunctionEntry
+}
+
+// Mangle devuelve la firma con la que se distingue una sobrecarga, p. ej.
+// `max(int,int)` o `swap(ref int,ref int)`.
+func Mangle(name string, params []*VariableEntry) string {
+	kinds := make([]string, len(params))
+	for i, param := range params {
+		kinds[i] = paramKind(param.Type, param.ByRef)
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(kinds, ","))
+}
+
+// Overloads devuelve las funciones declaradas con ese nombre, en orden.
+func (fd *FunctionDirectory) Overloads(name string) []*FunctionEntry {
+	return fd.Functions[name]
+}
+
+// overload busca la sobrecarga de name con los mismos tipos de parámetros.
+// El modo (`ref`) y el tipo de retorno no distinguen sobrecargas.
+func (fd *FunctionDirectory) overload(name string, params []*VariableSpec) *FunctionEntry {
+	for _, fn := range fd.Functions[name] {
+		entries := fn.Params.Entries()
+		if len(entries) != len(params) {
+			continue
+		}
+		same := true
+		for i, param := range entries {
+			if param.Type != params[i].Type {
+				same = false
+				break
+			}
+		}
+		if same {
+			return fn
+		}
+	}
+	return nil
+}
+
+func (fd *FunctionDirectory) addFunction(fn *FunctionEntry) {
+	fd.Functions[fn.Name] = append(fd.Functions[fn.Name], fn)
+	fd.functionOrder = append(fd.functionOrder, fn)
+}
+
+// AssignSymbols da a cada función su símbolo definitivo: el nombre si es la
+// única con ese nombre y su firma si está sobrecargada.
+func (fd *FunctionDirectory) AssignSymbols() {
+	for _, fn := range fd.functionOrder {
+		fn.Symbol = fn.Name
+		if len(fd.Functions[fn.Name]) > 1 {
+			fn.Symbol = Mangle(fn.Name, fn.Params.Entries())
+		}
+	}
+}
+
+// AcceptsArgument indica si un argumento del tipo dado puede pasarse al
+// parámetro y cuántas promociones necesita (0 si el tipo es el mismo). Un
+// parámetro por valor acepta lo que el cubo permite asignarle; uno `ref`
+// sólo su mismo tipo, porque comparte la celda del argumento.
+func AcceptsArgument(ctx *Context, param *VariableEntry, arg Type) (int, bool) {
+	if arg == param.Type || arg == TypeInvalid {
+		return 0, true
+	}
+	if param.ByRef {
+		return 0, false
+	}
+	if _, err := ctx.Cube.Result(OpAssign, param.Type, arg); err != nil {
+		return 0, false
+	}
+	return 1, true
+}
+
+// ResolveCall elige la función que atiende una llamada a name. Sin
+// sobrecargas regresa la única función aunque los argumentos no coincidan
+// (quien llama reporta el detalle); con sobrecargas elige, entre las que
+// aceptan los argumentos, la que necesita menos promociones. Regresa nil
+// después de reportar si no hay función, si ninguna sobrecarga acepta los
+// argumentos o si la mejor no es única.
+func ResolveCall(ctx *Context, name string, pos token.Pos, argTypes []Type) *FunctionEntry {
+	candidates := ctx.Directory.Overloads(name)
+	switch len(candidates) {
+	case 0:
+		ctx.Report(NewDiagnostic(CodeUndeclaredFunction, pos, len(name), "función '%s' no declarada", name))
+		return nil
+	case 1:
+		return candidates[0]
+	}
+
+	var best []*FunctionEntry
+	bestCost := -1
+	for _, fn := range candidates {
+		cost, ok := callCost(ctx, fn, argTypes)
+		if !ok {
+			continue
+		}
+		switch {
+		case bestCost < 0 || cost < bestCost:
+			best, bestCost = []*FunctionEntry{fn}, cost
+		case cost == bestCost:
+			best = append(best, fn)
+		}
+	}
+
+	switch len(best) {
+	case 0:
+		ctx.Report(NewDiagnostic(CodeNoMatchingOverload, pos, len(name), "ninguna sobrecarga de '%s' acepta los argumentos (%s); sobrecargas: %s",
+			name, typeList(argTypes), signatures(candidates)))
+		return nil
+	case 1:
+		return best[0]
+	default:
+		ctx.Report(NewDiagnostic(CodeAmbiguousCall, pos, len(name), "llamada ambigua a '%s' con los argumentos (%s): %s",
+			name, typeList(argTypes), signatures(best)))
+		return nil
+	}
+}
+
+// callCost suma las promociones que necesitan los argumentos para llamar a
+// fn; ok es false si el número de argumentos o algún tipo no coincide.
+func callCost(ctx *Context, fn *FunctionEntry, argTypes []Type) (int, bool) {
+	params := fn.Params.Entries()
+	if len(params) != len(argTypes) {
+		return 0, false
+	}
+	total := 0
+	for i, param := range params {
+		cost, ok := AcceptsArgument(ctx, param, argTypes[i])
+		if !ok {
+			return 0, false
+		}
+		total += cost
+	}
+	return total, true
+}
+
+func typeList(types []Type) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+func signatures(functions []*FunctionEntry) string {
+	names := make([]string, len(functions))
+	for i, fn := range functions {
+		names[i] = Mangle(fn.Name, fn.Params.Entries())
+	}
+	return strings.Join(names, ", ")
+}
+
+// GenerateCallQuadruple genera ERA o GOSUB hacia fn y guarda el cuádruplo
+// para ResolveFunctionSymbols.
+func GenerateCallQuadruple(ctx *Context, operator string, fn *FunctionEntry, result string) {
+	ctx.Calls = append(ctx.Calls, CallSite{Index: ctx.Quadruples.NextIndex(), Function: fn})
+	generateQuadruple(ctx, operator, fn.Symbol, "", result)
+}
+
+// ResolveFunctionSymbols asigna los símbolos definitivos de las funciones y
+// los escribe en los ERA y GOSUB ya generados: una llamada hecha antes de
+// declarar otra sobrecarga usó el nombre sin firma.
+func ResolveFunctionSymbols(ctx *Context) {
+	ctx.Directory.AssignSymbols()
+	for _, call := range ctx.Calls {
+		quad := ctx.Quadruples.GetAt(call.Index)
+		if quad == nil {
+			continue
+		}
+		quad.Operand1 = call.Function.Symbol
+		ctx.Quadruples.UpdateAt(call.Index, *quad)
+	}
+}
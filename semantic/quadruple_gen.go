@@ -2,7 +2,11 @@ package semantic
 
 import (
 	"Patito/token"
+	"Patito/util"
+	"errors"
 	"fmt"
+	"strconv"
+	"unicode/utf8"
 )
 
 // GenerateQuadruple es un wrapper de generateQuadruple
@@ -14,11 +18,11 @@ func GenerateQuadruple(ctx *Context, op, op1, op2, result string) {
 // Mayor número = mayor precedencia
 func getOperatorPrecedence(op string) int {
 	switch op {
-	case "*", "/":
+	case "*", "/", "%":
 		return 3
 	case "+", "-":
 		return 2
-	case ">", "<", "!=", "==":
+	case ">", "<", "!=", "==", ">=", "<=":
 		return 1
 	case "=":
 		return 0
@@ -27,6 +31,11 @@ func getOperatorPrecedence(op string) int {
 	}
 }
 
+// isRelationalOperator indica si op es uno de los operadores de comparación
+func isRelationalOperator(op string) bool {
+	return getOperatorPrecedence(op) == 1
+}
+
 // generateQuadruple genera un cuádruplo y lo agrega a la fila
 func generateQuadruple(ctx *Context, op, op1, op2, result string) {
 	quad := Quadruple{
@@ -40,79 +49,101 @@ func generateQuadruple(ctx *Context, op, op1, op2, result string) {
 	// fmt.Fprintf(os.Stderr, "[DEBUG] Quad %d: %s\n", index, quad.String())
 }
 
+// generateBinary desapila dos operandos, valida `op` con el cubo semántico y
+// genera su cuádruplo con un temporal. pos es la posición del operador.
+func generateBinary(ctx *Context, op string, pos token.Pos) error {
+	// Obtener operandos y tipos
+	right, ok1 := ctx.OperandStack.Pop()
+	rightType, _ := ctx.TypeStack.Pop()
+	left, ok2 := ctx.OperandStack.Pop()
+	leftType, _ := ctx.TypeStack.Pop()
+
+	if !ok1 || !ok2 {
+		return internalError("operandos insuficientes para operador %s", op)
+	}
+
+	// Validar con cubo semántico. Si algún operando ya tenía un error
+	// reportado, el resultado también es inválido pero no se repite el error.
+	resultType := TypeInvalid
+	if leftType != TypeInvalid && rightType != TypeInvalid {
+		var err error
+		resultType, err = ctx.Cube.Result(Operator(op), leftType, rightType)
+		if err != nil {
+			ctx.Report(NewDiagnostic(CodeInvalidOperation, pos, len(op), "%s", err))
+		}
+	}
+
+	if d := ctx.ConstValue; d != nil {
+		d.push(ctx, d.binary(left, right, func(l, r interface{}) (interface{}, error) {
+			if isRelationalOperator(op) {
+				return EvalRelational(op, l, r)
+			}
+			return EvalArithmetic(op, l, r)
+		}), resultType)
+		return nil
+	}
+
+	// Generar temporal (dirección virtual) y cuádruplo
+	temp := ctx.NewTemp()
+	generateQuadruple(ctx, op, left, right, temp)
+
+	// Apilar resultado
+	PushOperand(ctx, temp, resultType)
+	return nil
+}
+
 // ProcessOperator procesa un operador según el algoritmo de traducción
-func ProcessOperator(ctx *Context, op string) error {
+func ProcessOperator(ctx *Context, op string, pos token.Pos) error {
 	// Mientras haya operadores en la pila con mayor o igual precedencia
-	// fmt.Printf("Tenemos operador %v", op)
-	// fmt.Printf("\n")
 	for !ctx.OpStack.IsEmpty() {
 		topOp, _ := ctx.OpStack.Top()
 		if topOp == "(" {
 			break // Paréntesis de apertura, no procesar
 		}
-		if getOperatorPrecedence(topOp) >= getOperatorPrecedence(op) {
-			// Generar cuádruplo para el operador del tope
-			ctx.OpStack.Pop()
-
-			// Obtener operandos y tipos
-			right, ok1 := ctx.OperandStack.Pop()
-			// fmt.Printf("Tenemos operando derecho %v", right)
-			// fmt.Printf("\n")
-			rightType, _ := ctx.TypeStack.Pop()
-			left, ok2 := ctx.OperandStack.Pop()
-			// fmt.Printf("Tenemos operando izquierdo %v", left)
-			// fmt.Printf("\n")
-			leftType, _ := ctx.TypeStack.Pop()
-
-			if !ok1 || !ok2 {
-				return fmt.Errorf("error: operandos insuficientes para operador %s", topOp)
-			}
-
-			// Validar con cubo semántico
-			operator := Operator(topOp)
-			resultType, err := ctx.Cube.Result(operator, leftType, rightType)
-			if err != nil {
-				return err
-			}
-
-			// Generar temporal (dirección virtual)
-			temp := ctx.TempCounter.NextString()
-
-			// Generar cuádruplo
-			generateQuadruple(ctx, topOp, left, right, temp)
-
-			// Apilar resultado
-			ctx.OperandStack.Push(temp)
-			ctx.TypeStack.Push(resultType)
-		} else {
+		if getOperatorPrecedence(topOp) < getOperatorPrecedence(op) {
 			break
 		}
+		// Generar cuádruplo para el operador del tope
+		_, topPos, _ := ctx.OpStack.PopAt()
+		if err := generateBinary(ctx, topOp, topPos); err != nil {
+			return err
+		}
 	}
 
 	// Apilar el nuevo operador
-	ctx.OpStack.Push(op)
+	ctx.OpStack.PushAt(op, pos)
 	return nil
 }
 
-// ProcessUnaryOperator procesa un operador unario
-func ProcessUnaryOperator(ctx *Context, op string) error {
+// ProcessUnaryOperator procesa un operador unario; pos es la posición del signo
+func ProcessUnaryOperator(ctx *Context, op string, pos token.Pos) error {
 	// Para operadores unarios, solo necesitamos el operando
 	operand, ok := ctx.OperandStack.Pop()
 	if !ok {
-		return fmt.Errorf("error: operando insuficiente para operador unario %s", op)
+		return internalError("operando insuficiente para operador unario %s", op)
 	}
 
 	operandType, _ := ctx.TypeStack.Pop()
 
 	// Validar con cubo semántico
-	operator := Operator(op)
-	resultType, err := ctx.Cube.ResultUnary(operator, operandType)
-	if err != nil {
-		return err
+	resultType := TypeInvalid
+	if operandType != TypeInvalid {
+		var err error
+		resultType, err = ctx.Cube.ResultUnary(Operator(op), operandType)
+		if err != nil {
+			ctx.Report(NewDiagnostic(CodeInvalidOperation, pos, 1, "%s", err))
+		}
+	}
+
+	if d := ctx.ConstValue; d != nil {
+		d.push(ctx, d.unary(operand, func(v interface{}) (interface{}, error) {
+			return EvalUnary(op, v)
+		}), resultType)
+		return nil
 	}
 
 	// Generar temporal (dirección virtual)
-	temp := ctx.TempCounter.NextString()
+	temp := ctx.NewTemp()
 
 	// Generar cuádruplo (operador unario, operando, vacío, resultado)
 	generateQuadruple(ctx, op, operand, "", temp)
@@ -144,38 +175,114 @@ func PushConstant(ctx *Context, tok *token.Token) error {
 	case token.TokMap.Type("cte_float"):
 		operandType = TypeFloat
 		value = string(tok.Lit)
+	case token.TokMap.Type("true"), token.TokMap.Type("false"):
+		operandType = TypeBool
+		value = string(tok.Lit)
+	case token.TokMap.Type("cte_string"):
+		operandType = TypeString
+		decoded, err := util.StringValue(tok.Lit)
+		if err != nil {
+			reportStringLiteral(ctx, tok, err)
+			PushOperand(ctx, string(tok.Lit), TypeInvalid)
+			return nil
+		}
+		value = decoded
+	case token.TokMap.Type("cte_char"):
+		operandType = TypeChar
+		value = string(util.RuneValue(tok.Lit))
 	default:
-		return fmt.Errorf("tipo de constante no soportado: %v", tok.Type)
+		return DiagnosticAt(CodeUnsupportedConstant, tok, "tipo de constante no soportado: %s", token.TokMap.Id(tok.Type))
 	}
 
-	// Buscar o crear entrada en tabla de constantes
-	entry, exists := ctx.ConstantTable.Get(value, operandType)
-	if !exists {
-		// Crear nueva constante con dirección virtual
-		address := ctx.AddressManager.NextConstant()
-		entry = ctx.ConstantTable.Add(value, operandType, address)
+	// En el valor de una constante el literal no se agrega a la tabla: sólo
+	// se agrega el resultado
+	if d := ctx.ConstValue; d != nil {
+		v, err := ParseValue(value, operandType)
+		d.push(ctx, constValue{value: v, err: err}, operandType)
+		return nil
 	}
 
 	// Apilar la dirección virtual como string
-	operand := AddressToString(entry.Address)
+	operand := AddressToString(constantAddress(ctx, value, operandType))
 	PushOperand(ctx, operand, operandType)
 	return nil
 }
 
-// PushVariable apila una variable y busca su tipo y dirección virtual en el directorio
+// constantAddress busca o crea la entrada de un valor en la tabla de
+// constantes. Strings y chars van en su propio segmento. Si el segmento se
+// agotó lo reporta y devuelve la dirección 0 sin agregar la entrada.
+func constantAddress(ctx *Context, value string, valueType Type) int {
+	entry, exists := ctx.ConstantTable.Get(value, valueType)
+	if !exists {
+		var address int
+		var err error
+		switch valueType {
+		case TypeString:
+			address, err = ctx.AddressManager.NextString()
+		case TypeChar:
+			address, err = ctx.AddressManager.NextChar()
+		default:
+			address, err = ctx.AddressManager.NextConstant()
+		}
+		if err != nil {
+			ctx.Report(err)
+			return 0
+		}
+		entry = ctx.ConstantTable.Add(value, valueType, address)
+	}
+	return entry.Address
+}
+
+// reportStringLiteral reporta un literal string mal formado subrayando sólo la
+// secuencia de escape que falló.
+func reportStringLiteral(ctx *Context, tok *token.Token, err error) {
+	var escErr *util.EscapeError
+	if !errors.As(err, &escErr) {
+		ctx.Report(DiagnosticAt(CodeUnsupportedConstant, tok, "%s", err))
+		return
+	}
+	pos := tok.Pos
+	pos.Offset += escErr.Offset
+	pos.Column += utf8.RuneCount(tok.Lit[:escErr.Offset])
+	width := utf8.RuneCount(tok.Lit[escErr.Offset : escErr.Offset+escErr.Len])
+	ctx.Report(NewDiagnostic(CodeUnsupportedConstant, pos, width, "%s", escErr.Msg))
+}
+
+// PushVariable apila una variable y busca su tipo y dirección virtual en el directorio.
+// Una variable no declarada se reporta y se apila con TypeInvalid para seguir
+// analizando la expresión.
 func PushVariable(ctx *Context, varName string, pos token.Pos) error {
+	if c, ok := LookupConstant(ctx, varName); ok {
+		if d := ctx.ConstValue; d != nil && c.Type != TypeInvalid {
+			d.push(ctx, constValue{value: c.Value}, c.Type)
+			return nil
+		}
+		PushOperand(ctx, AddressToString(c.Address), c.Type)
+		return nil
+	}
+
 	// Buscar variable primero en contexto, luego en directorio
 	varType, err := GetVariableTypeFromContext(ctx, varName)
 	if err != nil {
-		return err
+		ctx.Report(NewDiagnostic(CodeUndeclaredVariable, pos, len(varName), "variable '%s' no declarada", varName))
+		PushOperand(ctx, varName, TypeInvalid)
+		return nil
+	}
+	if !checkNotArray(ctx, varName, pos) || !checkNotRecord(ctx, varName, pos) {
+		PushOperand(ctx, varName, TypeInvalid)
+		return nil
 	}
 
 	// Obtener dirección virtual
 	address, err := GetVariableAddressFromContext(ctx, varName)
 	if err != nil {
-		return err
+		ctx.Report(NewDiagnostic(CodeUndeclaredVariable, pos, len(varName), "%s", err))
+		PushOperand(ctx, varName, TypeInvalid)
+		return nil
 	}
 
+	markNotConstant(ctx)
+
 	// Apilar la dirección virtual como string
 	operand := AddressToString(address)
 	PushOperand(ctx, operand, varType)
@@ -183,46 +290,23 @@ func PushVariable(ctx *Context, varName string, pos token.Pos) error {
 }
 
 // ProcessExpressionEnd procesa los operadores restantes al final de una expresión
-// No procesa operadores relacionales (>, <, !=, ==) - estos se procesan en ProcessRelationalExpression
+// No procesa operadores relacionales (>, <, >=, <=, !=, ==) - estos se procesan en ProcessRelationalExpression
 func ProcessExpressionEnd(ctx *Context) error {
 	for !ctx.OpStack.IsEmpty() {
 		op, _ := ctx.OpStack.Top()
 		// No procesar operadores relacionales aquí
-		if op == ">" || op == "<" || op == "!=" || op == "==" {
+		if isRelationalOperator(op) {
 			break
 		}
 		if op == "(" {
 			break
 		}
 
-		// Desapilar el operador
-		ctx.OpStack.Pop()
-
-		// Obtener operandos
-		right, ok1 := ctx.OperandStack.Pop()
-		rightType, _ := ctx.TypeStack.Pop()
-		left, ok2 := ctx.OperandStack.Pop()
-		leftType, _ := ctx.TypeStack.Pop()
-
-		if !ok1 || !ok2 {
-			return fmt.Errorf("error: operandos insuficientes para operador %s", op)
-		}
-
-		// Validar con cubo semántico
-		operator := Operator(op)
-		resultType, err := ctx.Cube.Result(operator, leftType, rightType)
-		if err != nil {
+		// Desapilar el operador y generar su cuádruplo
+		_, pos, _ := ctx.OpStack.PopAt()
+		if err := generateBinary(ctx, op, pos); err != nil {
 			return err
 		}
-
-		// Generar temporal (dirección virtual)
-		temp := ctx.TempCounter.NextString()
-
-		// Generar cuádruplo
-		generateQuadruple(ctx, op, left, right, temp)
-
-		// Apilar resultado
-		PushOperand(ctx, temp, resultType)
 	}
 	return nil
 }
@@ -237,27 +321,40 @@ func ProcessAssignment(ctx *Context, varName string, pos token.Pos) error {
 	// Obtener el resultado de la expresión
 	result, ok := ctx.OperandStack.Pop()
 	if !ok {
-		return fmt.Errorf("error: no hay resultado de expresión para asignar")
+		return internalError("no hay resultado de expresión para asignar")
 	}
 
 	resultType, _ := ctx.TypeStack.Pop()
 
+	if !checkNotConstant(ctx, varName, pos) {
+		return nil
+	}
+
 	// Verificar tipo de variable
 	varType, err := GetVariableTypeFromContext(ctx, varName)
 	if err != nil {
-		return err
+		ctx.Report(NewDiagnostic(CodeUndeclaredVariable, pos, len(varName), "variable '%s' no declarada", varName))
+		return nil
+	}
+	if !checkNotArray(ctx, varName, pos) || !checkNotRecord(ctx, varName, pos) {
+		return nil
 	}
 
-	// Validar asignación con cubo semántico
-	_, err = ctx.Cube.Result(OpAssign, varType, resultType)
-	if err != nil {
-		return err
+	// Validar asignación con cubo semántico (sin repetir errores de la expresión)
+	if resultType == TypeInvalid {
+		return nil
+	}
+	if _, err := ctx.Cube.Result(OpAssign, varType, resultType); err != nil {
+		ctx.Report(NewDiagnostic(CodeAssignmentMismatch, pos, len(varName),
+			"no se puede asignar un valor %s a la variable '%s' de tipo %s", resultType, varName, varType))
+		return nil
 	}
 
 	// Obtener dirección virtual de la variable
 	varAddress, err := GetVariableAddressFromContext(ctx, varName)
 	if err != nil {
-		return err
+		ctx.Report(NewDiagnostic(CodeUndeclaredVariable, pos, len(varName), "%s", err))
+		return nil
 	}
 
 	// Generar cuádruplo de asignación (usar dirección virtual)
@@ -267,14 +364,14 @@ func ProcessAssignment(ctx *Context, varName string, pos token.Pos) error {
 }
 
 // ProcessRelationalOperator procesa un operador relacional
-func ProcessRelationalOperator(ctx *Context, op string) error {
+func ProcessRelationalOperator(ctx *Context, op string, pos token.Pos) error {
 	// Procesar la expresión izquierda primero
 	if err := ProcessExpressionEnd(ctx); err != nil {
 		return err
 	}
 
 	// Apilar el operador relacional
-	ctx.OpStack.Push(op)
+	ctx.OpStack.PushAt(op, pos)
 
 	return nil
 }
@@ -287,60 +384,132 @@ func ProcessRelationalExpression(ctx *Context) error {
 	}
 
 	// Debe haber un operador relacional en la pila
-	relOp, ok := ctx.OpStack.Pop()
+	relOp, pos, ok := ctx.OpStack.PopAt()
 	if !ok {
-		return fmt.Errorf("error: se esperaba operador relacional")
+		return internalError("se esperaba operador relacional")
 	}
 
-	// Obtener operandos
+	// Generar cuádruplo con temporal para el resultado booleano
+	return generateBinary(ctx, relOp, pos)
+}
+
+// ProcessLogicalOperator procesa `&&` o `||` cuando el operando izquierdo ya
+// fue evaluado. Copia su valor al temporal que guardará el resultado y genera
+// el salto de corto circuito: con `&&` un izquierdo falso salta al final, con
+// `||` sólo se evalúa el derecho si el izquierdo es falso.
+func ProcessLogicalOperator(ctx *Context, op string) error {
+	left, ok := ctx.OperandStack.Pop()
+	if !ok {
+		return internalError("operando insuficiente para operador %s", op)
+	}
+	leftType, _ := ctx.TypeStack.Pop()
+
+	// En el valor de una constante el corto circuito lo resuelve ProcessLogicalEnd
+	if ctx.ConstValue != nil {
+		PushOperand(ctx, left, leftType)
+		return nil
+	}
+
+	temp := ctx.NewTemp()
+	generateQuadruple(ctx, "=", left, "", temp)
+
+	if op == string(OpAnd) {
+		ctx.JumpStack.Push(ctx.Quadruples.NextIndex())
+		generateQuadruple(ctx, "GOTOF", temp, "", "")
+	} else {
+		// GOTOF salta el GOTO siguiente para evaluar el operando derecho
+		generateQuadruple(ctx, "GOTOF", temp, "", fmt.Sprintf("%d", ctx.Quadruples.NextIndex()+2))
+		ctx.JumpStack.Push(ctx.Quadruples.NextIndex())
+		generateQuadruple(ctx, "GOTO", "", "", "")
+	}
+
+	PushOperand(ctx, temp, leftType)
+	return nil
+}
+
+// ProcessLogicalEnd cierra `izq op der`: valida los tipos, copia el operando
+// derecho al temporal del resultado y completa el salto pendiente.
+func ProcessLogicalEnd(ctx *Context, op string, pos token.Pos) error {
 	right, ok1 := ctx.OperandStack.Pop()
 	rightType, _ := ctx.TypeStack.Pop()
-	left, ok2 := ctx.OperandStack.Pop()
+	temp, ok2 := ctx.OperandStack.Pop()
 	leftType, _ := ctx.TypeStack.Pop()
-
-	if !ok1 || !ok2 {
-		return fmt.Errorf("error: operandos insuficientes para operador relacional %s", relOp)
+	jumpIndex, ok3 := 0, true
+	if ctx.ConstValue == nil {
+		jumpIndex, ok3 = ctx.JumpStack.Pop()
+	}
+	if !ok1 || !ok2 || !ok3 {
+		return internalError("estado inconsistente al cerrar operador %s", op)
 	}
 
-	// Validar con cubo semántico
-	operator := Operator(relOp)
-	resultType, err := ctx.Cube.Result(operator, leftType, rightType)
-	if err != nil {
-		return err
+	resultType := TypeInvalid
+	if leftType != TypeInvalid && rightType != TypeInvalid {
+		var err error
+		resultType, err = ctx.Cube.Result(Operator(op), leftType, rightType)
+		if err != nil {
+			ctx.Report(NewDiagnostic(CodeInvalidOperation, pos, len(op), "%s", err))
+		}
 	}
 
-	// Generar temporal para el resultado booleano (dirección virtual)
-	temp := ctx.TempCounter.NextString()
+	if d := ctx.ConstValue; d != nil {
+		d.push(ctx, d.logical(op, temp, right), resultType)
+		return nil
+	}
 
-	// Generar cuádruplo
-	generateQuadruple(ctx, relOp, left, right, temp)
+	generateQuadruple(ctx, "=", right, "", temp)
+	fillJump(ctx, jumpIndex, ctx.Quadruples.NextIndex())
 
-	// Apilar resultado
 	PushOperand(ctx, temp, resultType)
-
 	return nil
 }
 
-// ProcessPrint procesa una instrucción print
-func ProcessPrint(ctx *Context, value string, isString bool) {
-	if isString {
-		// Para strings, agregar a la tabla de constantes y usar su dirección virtual
-		// Buscar o crear entrada en tabla de constantes
-		entry, exists := ctx.ConstantTable.Get(value, TypeString)
-		if !exists {
-			// Crear nueva constante con dirección virtual
-			address := ctx.AddressManager.NextConstant()
-			entry = ctx.ConstantTable.Add(value, TypeString, address)
-		}
-		// Usar la dirección virtual como string en el cuádruplo
-		addressStr := AddressToString(entry.Address)
-		generateQuadruple(ctx, "PRINT", addressStr, "", "")
-	} else {
-		// Para expresiones, el valor ya está en la pila de operandos (es una dirección virtual)
-		generateQuadruple(ctx, "PRINT", value, "", "")
+// fillJump completa el destino (Result) del salto en jumpIndex.
+func fillJump(ctx *Context, jumpIndex, target int) {
+	quad := ctx.Quadruples.GetAt(jumpIndex)
+	if quad != nil {
+		quad.Result = fmt.Sprintf("%d", target)
+		ctx.Quadruples.UpdateAt(jumpIndex, *quad)
 	}
 }
 
+// checkCondition reporta si la condición de un if o while no es booleana.
+func checkCondition(ctx *Context, condType Type, pos token.Pos, stmt string) {
+	if condType != TypeBool && condType != TypeInvalid {
+		ctx.Report(NewDiagnostic(CodeConditionType, pos, 1,
+			"la condición de %s debe ser bool, se obtuvo %s", stmt, condType))
+	}
+}
+
+// ProcessPrint procesa una instrucción print. El valor es la dirección del
+// resultado de la expresión; los literales string ya son constantes en el
+// segmento de strings.
+func ProcessPrint(ctx *Context, value string) {
+	generateQuadruple(ctx, "PRINT", value, "", "")
+}
+
+// ProcessRead genera `(READ, tipo, , dirección)` para una variable de read.
+// El tipo va en el cuádruplo para que la VM sepa cómo interpretar la entrada.
+// Sólo se aceptan variables escalares declaradas.
+func ProcessRead(ctx *Context, varName string, pos token.Pos) {
+	if !checkNotConstant(ctx, varName, pos) {
+		return
+	}
+	varType, err := GetVariableTypeFromContext(ctx, varName)
+	if err != nil {
+		ctx.Report(NewDiagnostic(CodeUndeclaredVariable, pos, len(varName), "variable '%s' no declarada", varName))
+		return
+	}
+	if !checkNotArray(ctx, varName, pos) || !checkNotRecord(ctx, varName, pos) {
+		return
+	}
+	address, err := GetVariableAddressFromContext(ctx, varName)
+	if err != nil {
+		ctx.Report(NewDiagnostic(CodeUndeclaredVariable, pos, len(varName), "%s", err))
+		return
+	}
+	generateQuadruple(ctx, "READ", varType.String(), "", AddressToString(address))
+}
+
 // ProcessProgramStart genera un GOTO quadruple al inicio del programa
 // El índice se guarda para completarlo cuando se encuentre la función main
 // Debe ser llamado antes de que se generen otros cuádruplos para que sea el primero
@@ -353,9 +522,24 @@ func ProcessProgramStart(ctx *Context) int {
 
 // ProcessFunctionStart almacena el índice de inicio de una función
 // Debe ser llamado cuando el cuerpo de la función está a punto de comenzar
-func ProcessFunctionStart(ctx *Context, functionName string) {
+func ProcessFunctionStart(ctx *Context, fn *FunctionEntry) {
 	startIndex := ctx.Quadruples.NextIndex()
-	ctx.FunctionStartQuads[functionName] = startIndex
+	ctx.FunctionStartQuads[fn] = startIndex
+}
+
+// IsAssignableOperand indica si un operando es la celda de una variable
+// (global, local, elemento de arreglo `(t)` o campo de record), es decir, si
+// puede pasarse a un parámetro ref. Temporales y constantes no lo son.
+func IsAssignableOperand(operand string) bool {
+	if IsPointerOperand(operand) {
+		return true
+	}
+	addr, err := strconv.Atoi(operand)
+	if err != nil {
+		return false
+	}
+	segment := SegmentOf(addr)
+	return segment == SegmentGlobal || segment == SegmentLocal
 }
 
 // ProcessFunctionEnd genera el cuádruplo ENDFUNC al final de una función
@@ -372,15 +556,17 @@ func ProcessMainEnd(ctx *Context) {
 }
 
 // ProcessIf procesa el inicio de un if
-// Asume que la expresión condicional ya fue procesada y el resultado está en la pila
-func ProcessIf(ctx *Context) (int, error) {
+// Asume que la expresión condicional ya fue procesada y el resultado está en la pila;
+// pos es la posición de la palabra `if`
+func ProcessIf(ctx *Context, pos token.Pos) (int, error) {
 	// Obtener resultado de la condición (ya procesada)
 	condition, ok := ctx.OperandStack.Pop()
 	if !ok {
-		return -1, fmt.Errorf("error: no hay condición para if")
+		return -1, internalError("no hay condición para if")
 	}
 
-	ctx.TypeStack.Pop() // Remover tipo de la condición
+	condType, _ := ctx.TypeStack.Pop()
+	checkCondition(ctx, condType, pos, "if")
 
 	// Generar GOTOF (salto si falso)
 	gotoIndex := ctx.Quadruples.NextIndex()
@@ -397,7 +583,7 @@ func ProcessIfEnd(ctx *Context) error {
 	// Completar el GOTOF con el índice actual
 	jumpIndex, ok := ctx.JumpStack.Pop()
 	if !ok {
-		return fmt.Errorf("error: no hay salto pendiente para if")
+		return internalError("no hay salto pendiente para if")
 	}
 
 	// Actualizar el cuádruplo con el índice correcto (en Result)
@@ -415,7 +601,7 @@ func ProcessElse(ctx *Context) (int, error) {
 	// Completar el GOTOF del if con el inicio del else
 	jumpIndex, ok := ctx.JumpStack.Pop()
 	if !ok {
-		return -1, fmt.Errorf("error: no hay salto pendiente para else")
+		return -1, internalError("no hay salto pendiente para else")
 	}
 
 	// Generar GOTO incondicional para saltar el else
@@ -440,7 +626,7 @@ func ProcessIfElseEnd(ctx *Context) error {
 	// Completar el GOTO del else
 	jumpIndex, ok := ctx.JumpStack.Pop()
 	if !ok {
-		return fmt.Errorf("error: no hay salto pendiente para else")
+		return internalError("no hay salto pendiente para else")
 	}
 
 	// Actualizar el cuádruplo con el índice correcto (en Result)
@@ -458,33 +644,23 @@ func ProcessWhileStart(ctx *Context) int {
 	// Guardar el índice de inicio del ciclo
 	startIndex := ctx.Quadruples.NextIndex()
 	ctx.JumpStack.Push(startIndex)
+	// continue vuelve a evaluar la condición
+	openLoop(ctx, startIndex)
 	return startIndex
 }
 
 // ProcessWhileCondition procesa la condición del while
 // Asume que la expresión condicional ya fue procesada y el resultado está en la pila
-// Guarda el índice donde comienza el ciclo (donde se evalúa la condición)
-func ProcessWhileCondition(ctx *Context) error {
+// y que ProcessWhileStart ya guardó el índice donde comienza su evaluación
+func ProcessWhileCondition(ctx *Context, pos token.Pos) error {
 	// Obtener resultado de la condición (ya procesada)
 	condition, ok := ctx.OperandStack.Pop()
 	if !ok {
-		return fmt.Errorf("error: no hay condición para while")
+		return internalError("no hay condición para while")
 	}
 
-	ctx.TypeStack.Pop() // Remover tipo de la condición
-
-	// El índice de inicio del ciclo es donde se evalúa la condición
-	// Como la condición ya fue evaluada, el último cuádruplo generado es el de la condición
-	// (el que produce el resultado booleano). Ese es el índice donde comienza el ciclo.
-	// Usamos Size() en lugar de NextIndex() porque NextIndex() ya incluye el espacio para el GOTOF
-	quadSize := ctx.Quadruples.Size()
-	startIndex := quadSize - 1
-	if startIndex < 0 {
-		startIndex = 0
-	}
-
-	// Guardar el índice de inicio primero (para que sea el último en la pila)
-	ctx.JumpStack.Push(startIndex)
+	condType, _ := ctx.TypeStack.Pop()
+	checkCondition(ctx, condType, pos, "while")
 
 	// Generar GOTOF (salto si falso, salir del ciclo)
 	gotoIndex := ctx.Quadruples.NextIndex()
@@ -501,13 +677,13 @@ func ProcessWhileEnd(ctx *Context) error {
 	// Obtener el índice del GOTOF (último que se pusó)
 	gotoIndex, ok := ctx.JumpStack.Pop()
 	if !ok {
-		return fmt.Errorf("error: no hay salto pendiente para while")
+		return internalError("no hay salto pendiente para while")
 	}
 
 	// Obtener el índice de inicio del ciclo (penúltimo que se pusó)
 	startIndex, ok := ctx.JumpStack.Pop()
 	if !ok {
-		return fmt.Errorf("error: no hay índice de inicio para while")
+		return internalError("no hay índice de inicio para while")
 	}
 
 	// Generar GOTO al inicio del ciclo (donde se evalúa la condición)
@@ -520,11 +696,219 @@ func ProcessWhileEnd(ctx *Context) error {
 		ctx.Quadruples.UpdateAt(gotoIndex, *quad)
 	}
 
+	// Los break también salen aquí
+	return closeLoop(ctx)
+}
+
+// ProcessDoStart procesa el `do` de un do-while: guarda el inicio del cuerpo,
+// destino del salto de regreso.
+func ProcessDoStart(ctx *Context) {
+	ctx.JumpStack.Push(ctx.Quadruples.NextIndex())
+	// continue salta a la condición, que todavía no se genera
+	openLoop(ctx, -1)
+}
+
+// ProcessDoCondition marca el inicio de la condición de un do-while.
+func ProcessDoCondition(ctx *Context) {
+	setContinueTarget(ctx, ctx.Quadruples.NextIndex())
+}
+
+// ProcessDoWhileEnd cierra un do-while con un solo salto condicional hacia
+// atrás: (GOTOV, cond, , inicio) regresa al cuerpo mientras cond sea verdadera.
+func ProcessDoWhileEnd(ctx *Context, pos token.Pos) error {
+	condition, ok := ctx.OperandStack.Pop()
+	if !ok {
+		return internalError("no hay condición para do-while")
+	}
+	condType, _ := ctx.TypeStack.Pop()
+	checkCondition(ctx, condType, pos, "do-while")
+
+	startIndex, ok := ctx.JumpStack.Pop()
+	if !ok {
+		return internalError("no hay índice de inicio para do-while")
+	}
+	generateQuadruple(ctx, "GOTOV", condition, "", fmt.Sprintf("%d", startIndex))
+	return closeLoop(ctx)
+}
+
+// openLoop abre un ciclo para break/continue; continueTarget es -1 si el
+// destino de continue se conoce hasta después del cuerpo.
+func openLoop(ctx *Context, continueTarget int) {
+	ctx.Loops = append(ctx.Loops, &Loop{ContinueTarget: continueTarget})
+}
+
+// currentLoop regresa el ciclo más interno, o nil fuera de un ciclo.
+func currentLoop(ctx *Context) *Loop {
+	if len(ctx.Loops) == 0 {
+		return nil
+	}
+	return ctx.Loops[len(ctx.Loops)-1]
+}
+
+// setContinueTarget completa los continue pendientes del ciclo actual. Sólo se
+// llama al cerrar un ciclo, cuando ya no hay switch abiertos dentro de él.
+func setContinueTarget(ctx *Context, target int) {
+	loop := currentLoop(ctx)
+	if loop == nil {
+		return
+	}
+	loop.ContinueTarget = target
+	for _, jump := range loop.Continues {
+		fillJump(ctx, jump, target)
+	}
+	loop.Continues = nil
+}
+
+// closeLoop saca el ciclo actual y completa sus break con el índice siguiente.
+func closeLoop(ctx *Context) error {
+	loop := currentLoop(ctx)
+	if loop == nil {
+		return internalError("no hay ciclo abierto")
+	}
+	ctx.Loops = ctx.Loops[:len(ctx.Loops)-1]
+	for _, jump := range loop.Breaks {
+		fillJump(ctx, jump, ctx.Quadruples.NextIndex())
+	}
 	return nil
 }
 
+// ProcessBreak genera el GOTO de `break` hacia el final del ciclo o switch
+// más interno.
+func ProcessBreak(ctx *Context, pos token.Pos) {
+	loop := currentLoop(ctx)
+	if loop == nil {
+		ctx.Report(NewDiagnostic(CodeLoopControl, pos, len("break"), "break fuera de un ciclo"))
+		return
+	}
+	loop.Breaks = append(loop.Breaks, ctx.Quadruples.NextIndex())
+	generateQuadruple(ctx, "GOTO", "", "", "")
+}
+
+// ProcessContinue genera el GOTO de `continue` hacia la siguiente iteración
+// del ciclo más interno; los switch abiertos se ignoran.
+func ProcessContinue(ctx *Context, pos token.Pos) {
+	var loop *Loop
+	for i := len(ctx.Loops) - 1; i >= 0 && loop == nil; i-- {
+		if !ctx.Loops[i].Switch {
+			loop = ctx.Loops[i]
+		}
+	}
+	if loop == nil {
+		ctx.Report(NewDiagnostic(CodeLoopControl, pos, len("continue"), "continue fuera de un ciclo"))
+		return
+	}
+	if loop.ContinueTarget >= 0 {
+		generateQuadruple(ctx, "GOTO", "", "", fmt.Sprintf("%d", loop.ContinueTarget))
+		return
+	}
+	loop.Continues = append(loop.Continues, ctx.Quadruples.NextIndex())
+	generateQuadruple(ctx, "GOTO", "", "", "")
+}
+
+// ForLoop guarda lo que necesita ProcessForEnd para cerrar un for: la
+// dirección de la variable de control y el operando con el paso.
+type ForLoop struct {
+	Control string
+	Step    string
+}
+
+// checkLoopInt reporta si la variable, el límite o el paso de un for no es int.
+func checkLoopInt(ctx *Context, t Type, pos token.Pos, width int, what string) {
+	if t != TypeInt && t != TypeInvalid {
+		ctx.Report(NewDiagnostic(CodeLoopType, pos, width, "%s de for debe ser int, se obtuvo %s", what, t))
+	}
+}
+
+// ProcessForInit procesa `for i = a`: valida que i sea una variable int y le
+// asigna el valor inicial, que ya está en la pila de operandos.
+func ProcessForInit(ctx *Context, varName string, pos token.Pos, initPos token.Pos) (*ForLoop, error) {
+	initial, ok := ctx.OperandStack.Pop()
+	if !ok {
+		return nil, internalError("no hay valor inicial para for")
+	}
+	initType, _ := ctx.TypeStack.Pop()
+
+	control := varName
+	if checkNotConstant(ctx, varName, pos) {
+		varType, err := GetVariableTypeFromContext(ctx, varName)
+		if err != nil {
+			ctx.Report(NewDiagnostic(CodeUndeclaredVariable, pos, len(varName), "variable '%s' no declarada", varName))
+		} else if checkNotArray(ctx, varName, pos) {
+			checkLoopInt(ctx, varType, pos, len(varName), "la variable de control")
+			if address, err := GetVariableAddressFromContext(ctx, varName); err == nil {
+				control = AddressToString(address)
+			}
+		}
+	}
+	checkLoopInt(ctx, initType, initPos, 1, "el valor inicial")
+
+	generateQuadruple(ctx, "=", initial, "", control)
+	return &ForLoop{Control: control}, nil
+}
+
+// ProcessForHead procesa `to b [step c]`. El límite y el paso se evalúan una
+// sola vez y se copian a temporales; después se genera la prueba de salida:
+//
+//	<= i, lim, t                 (sin step)
+//	-  i, lim, t1                (con step: (i - lim) * paso <= 0, que
+//	*  t1, paso, t2               sirve para pasos positivos y negativos)
+//	<= t2, 0, t
+//	GOTOF t, , fin
+//
+// El inicio de la prueba y el GOTOF quedan en la JumpStack, como en while.
+func ProcessForHead(ctx *Context, loop *ForLoop, limitPos token.Pos, stepPos token.Pos, hasStep bool) error {
+	if !hasStep {
+		loop.Step = constantOperand(ctx, 1)
+	} else {
+		step, ok := ctx.OperandStack.Pop()
+		if !ok {
+			return internalError("no hay paso para for")
+		}
+		stepType, _ := ctx.TypeStack.Pop()
+		checkLoopInt(ctx, stepType, stepPos, 1, "el paso")
+		loop.Step = ctx.NewTemp()
+		generateQuadruple(ctx, "=", step, "", loop.Step)
+	}
+
+	limit, ok := ctx.OperandStack.Pop()
+	if !ok {
+		return internalError("no hay límite para for")
+	}
+	limitType, _ := ctx.TypeStack.Pop()
+	checkLoopInt(ctx, limitType, limitPos, 1, "el límite")
+	limitTemp := ctx.NewTemp()
+	generateQuadruple(ctx, "=", limit, "", limitTemp)
+
+	ctx.JumpStack.Push(ctx.Quadruples.NextIndex())
+	// continue salta al incremento, que se genera al cerrar el ciclo
+	openLoop(ctx, -1)
+	condition := ctx.NewTemp()
+	if hasStep {
+		diff := ctx.NewTemp()
+		generateQuadruple(ctx, "-", loop.Control, limitTemp, diff)
+		scaled := ctx.NewTemp()
+		generateQuadruple(ctx, "*", diff, loop.Step, scaled)
+		generateQuadruple(ctx, "<=", scaled, constantOperand(ctx, 0), condition)
+	} else {
+		generateQuadruple(ctx, "<=", loop.Control, limitTemp, condition)
+	}
+
+	ctx.JumpStack.Push(ctx.Quadruples.NextIndex())
+	generateQuadruple(ctx, "GOTOF", condition, "", "")
+	return nil
+}
+
+// ProcessForEnd incrementa la variable de control, regresa a la prueba de
+// salida y completa el GOTOF. Los continue pendientes saltan al incremento.
+func ProcessForEnd(ctx *Context, loop *ForLoop) error {
+	setContinueTarget(ctx, ctx.Quadruples.NextIndex())
+	generateQuadruple(ctx, "+", loop.Control, loop.Step, loop.Control)
+	return ProcessWhileEnd(ctx)
+}
+
 // ProcessReturn processes a return statement with an expression (non-void functions)
-func ProcessReturn(ctx *Context, exprValue string, exprType Type) error {
+// pos is the position of the `return` keyword, used when validating the function type
+func ProcessReturn(ctx *Context, exprValue string, exprType Type, pos token.Pos) error {
 	fnName := ""
 	if ctx.CurrentFunction != nil {
 		fnName = ctx.CurrentFunction.Name
@@ -532,6 +916,7 @@ func ProcessReturn(ctx *Context, exprValue string, exprType Type) error {
 	ctx.PendingReturns = append(ctx.PendingReturns, PendingReturn{
 		Value:    exprValue,
 		Type:     exprType,
+		Pos:      pos,
 		Function: fnName,
 	})
 	generateQuadruple(ctx, "RETURN", exprValue, "", "")
@@ -540,8 +925,7 @@ func ProcessReturn(ctx *Context, exprValue string, exprType Type) error {
 }
 
 // ProcessReturnVoid processes a return statement without expression (void functions)
-func ProcessReturnVoid(ctx *Context) error {
-
+func ProcessReturnVoid(ctx *Context, pos token.Pos) error {
 	fnName := ""
 	if ctx.CurrentFunction != nil {
 		fnName = ctx.CurrentFunction.Name
@@ -549,6 +933,7 @@ func ProcessReturnVoid(ctx *Context) error {
 	ctx.PendingReturns = append(ctx.PendingReturns, PendingReturn{
 		Value:    "",
 		Type:     TypeVoid,
+		Pos:      pos,
 		Function: fnName,
 	})
 	generateQuadruple(ctx, "RETURN", "", "", "")
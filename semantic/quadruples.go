@@ -1,6 +1,10 @@
 package semantic
 
-import "fmt"
+import (
+	"fmt"
+
+	"Patito/token"
+)
 
 // Quadruple representa un cuádruplo en el código intermedio
 // Formato: (operador, operando1, operando2, resultado)
@@ -75,31 +79,48 @@ func (q *QuadrupleQueue) String() string {
 	return result
 }
 
-// OperatorStack es una pila para operadores
+// OperatorStack es una pila para operadores. Junto a cada operador guarda la
+// posición del token que lo originó para reportar diagnósticos precisos.
 type OperatorStack struct {
 	operators []string
+	positions []token.Pos
 }
 
 // NewOperatorStack crea una nueva pila de operadores
 func NewOperatorStack() *OperatorStack {
 	return &OperatorStack{
 		operators: make([]string, 0),
+		positions: make([]token.Pos, 0),
 	}
 }
 
 // Push agrega un operador a la pila
 func (s *OperatorStack) Push(op string) {
+	s.PushAt(op, token.Pos{})
+}
+
+// PushAt agrega un operador a la pila junto con su posición en el fuente
+func (s *OperatorStack) PushAt(op string, pos token.Pos) {
 	s.operators = append(s.operators, op)
+	s.positions = append(s.positions, pos)
 }
 
 // Pop elimina y devuelve el operador del tope de la pila
 func (s *OperatorStack) Pop() (string, bool) {
+	op, _, ok := s.PopAt()
+	return op, ok
+}
+
+// PopAt elimina y devuelve el operador del tope y su posición
+func (s *OperatorStack) PopAt() (string, token.Pos, bool) {
 	if len(s.operators) == 0 {
-		return "", false
+		return "", token.Pos{}, false
 	}
 	top := s.operators[len(s.operators)-1]
+	pos := s.positions[len(s.positions)-1]
 	s.operators = s.operators[:len(s.operators)-1]
-	return top, true
+	s.positions = s.positions[:len(s.positions)-1]
+	return top, pos, true
 }
 
 // Top devuelve el operador del tope sin eliminarlo
@@ -252,7 +273,7 @@ func (tc *TempCounter) SetAddressManager(vam *VirtualAddressManager) {
 }
 
 // Next genera la siguiente dirección virtual temporal
-func (tc *TempCounter) Next() int {
+func (tc *TempCounter) Next() (int, error) {
 	if tc.addressManager == nil {
 		panic("TempCounter: addressManager no inicializado")
 	}
@@ -260,8 +281,9 @@ func (tc *TempCounter) Next() int {
 }
 
 // NextString genera la siguiente dirección temporal como string
-func (tc *TempCounter) NextString() string {
-	return AddressToString(tc.Next())
+func (tc *TempCounter) NextString() (string, error) {
+	addr, err := tc.Next()
+	return AddressToString(addr), err
 }
 
 // Reset reinicia el contador (reinicia el contador de temporales en el address manager)
@@ -0,0 +1,177 @@
+package semantic
+
+import "Patito/token"
+
+// FieldEntry es un campo de un tipo record. Offset es su posición (en celdas)
+// a partir de la dirección de la variable.
+type FieldEntry struct {
+	Name       string
+	Type       Type
+	Offset     int
+	DeclaredAt token.Pos
+}
+
+// RecordType es un tipo declarado con `type T = record { ... };`. Una variable
+// record ocupa Size celdas contiguas, una por campo.
+type RecordType struct {
+	Name       string
+	DeclaredAt token.Pos
+	Fields     []*FieldEntry
+	Size       int
+}
+
+// Field busca un campo por nombre.
+func (r *RecordType) Field(name string) (*FieldEntry, bool) {
+	for _, f := range r.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// layout asigna a cada campo su desplazamiento: los campos ocupan celdas
+// contiguas en orden de declaración, así que el record ocupa una celda por
+// campo.
+func (r *RecordType) layout() {
+	for i, field := range r.Fields {
+		field.Offset = i
+	}
+	r.Size = len(r.Fields)
+}
+
+// AddRecord registra un tipo record con los campos en el orden declarado y
+// calcula su layout. Si el nombre o un campo se repiten se regresa el error
+// junto con el record, que se puede seguir usando para analizar el resto del
+// programa.
+func (fd *FunctionDirectory) AddRecord(name string, pos token.Pos, fields []*VariableSpec) (*RecordType, error) {
+	if existing, ok := fd.Records[name]; ok {
+		return existing, &DuplicateSymbolError{Name: name, Scope: ScopeGlobal, FirstPos: existing.DeclaredAt, SecondPos: pos}
+	}
+
+	record := &RecordType{Name: name, DeclaredAt: pos}
+	var err error
+	seen := make(map[string]*FieldEntry)
+	for _, spec := range fields {
+		if first, dup := seen[spec.Name]; dup {
+			if err == nil {
+				err = &DuplicateSymbolError{Name: spec.Name, Scope: ScopeGlobal, FirstPos: first.DeclaredAt, SecondPos: spec.Pos}
+			}
+			continue
+		}
+		field := &FieldEntry{Name: spec.Name, Type: spec.Type, DeclaredAt: spec.Pos}
+		seen[spec.Name] = field
+		record.Fields = append(record.Fields, field)
+	}
+	record.layout()
+
+	fd.Records[name] = record
+	fd.recordOrder = append(fd.recordOrder, record)
+	return record, err
+}
+
+// GetRecord busca un tipo record por nombre.
+func (fd *FunctionDirectory) GetRecord(name string) (*RecordType, bool) {
+	record, ok := fd.Records[name]
+	return record, ok
+}
+
+// OrderedRecords devuelve los tipos record en orden de declaración.
+func (fd *FunctionDirectory) OrderedRecords() []*RecordType {
+	result := make([]*RecordType, len(fd.recordOrder))
+	copy(result, fd.recordOrder)
+	return result
+}
+
+// GetVariableRecordFromContext devuelve el tipo record de una variable (nil
+// si no es record o no está declarada)
+func GetVariableRecordFromContext(ctx *Context, name string) *RecordType {
+	if sym, ok := ctx.LookupVariable(name); ok {
+		return sym.Record
+	}
+	return nil
+}
+
+// checkNotRecord reporta el uso de un record completo donde se espera un valor.
+func checkNotRecord(ctx *Context, varName string, pos token.Pos) bool {
+	record := GetVariableRecordFromContext(ctx, varName)
+	if record == nil {
+		return true
+	}
+	ctx.Report(NewDiagnostic(CodeRecordField, pos, len(varName),
+		"el record '%s' de tipo %s debe usarse con un campo, p. ej. %s.%s", varName, record.Name, varName, record.Fields[0].Name))
+	return false
+}
+
+// fieldAddress resuelve `nombre.campo`. Los campos están en celdas contiguas,
+// así que la dirección se conoce en compilación: la de la variable más el
+// desplazamiento del campo. ok es false si hubo un error (ya reportado).
+func fieldAddress(ctx *Context, varName string, pos token.Pos, fieldName string, fieldPos token.Pos) (int, Type, bool) {
+	if _, err := GetVariableTypeFromContext(ctx, varName); err != nil {
+		ctx.Report(NewDiagnostic(CodeUndeclaredVariable, pos, len(varName), "variable '%s' no declarada", varName))
+		return 0, TypeInvalid, false
+	}
+	record := GetVariableRecordFromContext(ctx, varName)
+	if record == nil {
+		if varType, _ := GetVariableTypeFromContext(ctx, varName); varType != TypeInvalid {
+			ctx.Report(NewDiagnostic(CodeRecordField, pos, len(varName), "la variable '%s' no es un record", varName))
+		}
+		return 0, TypeInvalid, false
+	}
+	field, ok := record.Field(fieldName)
+	if !ok {
+		ctx.Report(NewDiagnostic(CodeRecordField, fieldPos, len(fieldName),
+			"el record %s no tiene el campo '%s'", record.Name, fieldName))
+		return 0, TypeInvalid, false
+	}
+	base, err := GetVariableAddressFromContext(ctx, varName)
+	if err != nil {
+		ctx.Report(NewDiagnostic(CodeUndeclaredVariable, pos, len(varName), "%s", err))
+		return 0, TypeInvalid, false
+	}
+	return base + field.Offset, field.Type, true
+}
+
+// ProcessFieldAccess apila `nombre.campo` como operando.
+func ProcessFieldAccess(ctx *Context, varName string, pos token.Pos, fieldName string, fieldPos token.Pos) {
+	addr, fieldType, ok := fieldAddress(ctx, varName, pos, fieldName, fieldPos)
+	if !ok {
+		PushOperand(ctx, varName+"."+fieldName, TypeInvalid)
+		return
+	}
+	markNotConstant(ctx)
+	PushOperand(ctx, AddressToString(addr), fieldType)
+}
+
+// ProcessFieldAssignment procesa `nombre.campo = expr`.
+func ProcessFieldAssignment(ctx *Context, varName string, pos token.Pos, fieldName string, fieldPos token.Pos) error {
+	if err := ProcessExpressionEnd(ctx); err != nil {
+		return err
+	}
+	result, ok := ctx.OperandStack.Pop()
+	if !ok {
+		return internalError("no hay resultado de expresión para asignar")
+	}
+	resultType, _ := ctx.TypeStack.Pop()
+
+	addr, fieldType, ok := fieldAddress(ctx, varName, pos, fieldName, fieldPos)
+	if !ok || resultType == TypeInvalid {
+		return nil
+	}
+	if _, err := ctx.Cube.Result(OpAssign, fieldType, resultType); err != nil {
+		ctx.Report(NewDiagnostic(CodeAssignmentMismatch, fieldPos, len(fieldName),
+			"no se puede asignar un valor %s al campo '%s.%s' de tipo %s", resultType, varName, fieldName, fieldType))
+		return nil
+	}
+	generateQuadruple(ctx, "=", result, "", AddressToString(addr))
+	return nil
+}
+
+// ProcessReadField genera el READ de `read(nombre.campo)`.
+func ProcessReadField(ctx *Context, varName string, pos token.Pos, fieldName string, fieldPos token.Pos) {
+	addr, fieldType, ok := fieldAddress(ctx, varName, pos, fieldName, fieldPos)
+	if !ok {
+		return
+	}
+	generateQuadruple(ctx, "READ", fieldType.String(), "", AddressToString(addr))
+}
@@ -0,0 +1,124 @@
+package semantic
+
+import (
+	"fmt"
+
+	"Patito/token"
+)
+
+// Symbol es una variable o constante visible mientras se analiza el programa.
+type Symbol struct {
+	Name    string
+	Type    Type
+	Address int
+	Dims    []int       // nil para escalares
+	Record  *RecordType // tipo record si Type es TypeRecord
+	Pos     token.Pos
+	// Constant indica una constante declarada con `const`: Address es su
+	// entrada en la tabla de constantes (0 si el valor no se pudo calcular)
+	// y Value su valor
+	Constant bool
+	Value    interface{}
+}
+
+// Scope es un nivel de la pila de scopes: el global, el de una función
+// (parámetros y locales de `[ ]`) o un bloque con sus declaraciones `var`.
+type Scope struct {
+	Kind    ScopeKind
+	symbols map[string]*Symbol
+	// localMark es el contador de locales al abrir un bloque; al cerrarlo se
+	// restaura para que el siguiente bloque reutilice esas direcciones
+	localMark int
+}
+
+// PushScope abre un scope dentro del actual. Un bloque que se abre
+// directamente en el scope global es el cuerpo de main, que empieza sus
+// locales desde la base del segmento.
+func (c *Context) PushScope(kind ScopeKind) *Scope {
+	if kind == ScopeBlock && c.CurrentScope().Kind == ScopeGlobal {
+		c.AddressManager.ResetLocals()
+	}
+	scope := &Scope{
+		Kind:      kind,
+		symbols:   make(map[string]*Symbol),
+		localMark: c.AddressManager.LocalMark(),
+	}
+	c.Scopes = append(c.Scopes, scope)
+	return scope
+}
+
+// PopScope cierra el scope actual; al cerrar un bloque sus direcciones
+// locales quedan libres. El scope global no se cierra.
+func (c *Context) PopScope() error {
+	if len(c.Scopes) < 2 {
+		return internalError("no hay scope que cerrar")
+	}
+	scope := c.CurrentScope()
+	c.Scopes = c.Scopes[:len(c.Scopes)-1]
+	if scope.Kind == ScopeBlock {
+		c.AddressManager.ReleaseLocals(scope.localMark)
+	}
+	return nil
+}
+
+// CurrentScope devuelve el scope más interno.
+func (c *Context) CurrentScope() *Scope {
+	return c.Scopes[len(c.Scopes)-1]
+}
+
+// Declare registra la variable en el scope actual. Un nombre repetido en el
+// mismo scope regresa DuplicateSymbolError y se conserva la primera
+// declaración; en un scope interno el nombre oculta al de los externos.
+func (c *Context) Declare(spec *VariableSpec) error {
+	return c.declare(&Symbol{
+		Name:    spec.Name,
+		Type:    spec.Type,
+		Address: spec.Address,
+		Dims:    spec.Dims,
+		Record:  spec.Record,
+		Pos:     spec.Pos,
+	})
+}
+
+func (c *Context) declare(sym *Symbol) error {
+	scope := c.CurrentScope()
+	if first, ok := scope.symbols[sym.Name]; ok {
+		return &DuplicateSymbolError{Name: sym.Name, Scope: scope.Kind, FirstPos: first.Pos, SecondPos: sym.Pos}
+	}
+	scope.symbols[sym.Name] = sym
+	return nil
+}
+
+// Lookup busca un nombre del scope más interno hacia el global.
+func (c *Context) Lookup(name string) (*Symbol, bool) {
+	for i := len(c.Scopes) - 1; i >= 0; i-- {
+		if sym, ok := c.Scopes[i].symbols[name]; ok {
+			return sym, true
+		}
+	}
+	return nil, false
+}
+
+// LookupVariable busca una variable como Lookup; si el nombre visible es una
+// constante no hay variable con ese nombre.
+func (c *Context) LookupVariable(name string) (*Symbol, bool) {
+	sym, ok := c.Lookup(name)
+	if !ok || sym.Constant {
+		return nil, false
+	}
+	return sym, true
+}
+
+// ProcessBlockVariable genera el INIT de una variable declarada en un
+// bloque: como sus direcciones se reutilizan entre bloques, cada vez que se
+// entra al bloque sus celdas vuelven al valor inicial de su tipo.
+func ProcessBlockVariable(ctx *Context, spec *VariableSpec) {
+	switch {
+	case spec.Record != nil:
+		for _, field := range spec.Record.Fields {
+			generateQuadruple(ctx, "INIT", field.Type.String(), "1", AddressToString(spec.Address+field.Offset))
+		}
+	case spec.Type != TypeInvalid:
+		generateQuadruple(ctx, "INIT", spec.Type.String(), fmt.Sprintf("%d", ArraySize(spec.Dims)), AddressToString(spec.Address))
+	}
+}
@@ -0,0 +1,128 @@
+package semantic
+
+import "Patito/token"
+
+// Switch guarda el estado de un switch mientras se compilan sus casos.
+type Switch struct {
+	Value     string            // temporal con el valor evaluado una sola vez
+	Valid     bool              // la expresión es int; si no, sólo se valida la sintaxis
+	NextCase  int               // GOTOF del último case, salta a la siguiente comparación; -1 si no hay
+	InBody    bool              // ya se generó el cuerpo de algún case
+	Cases     map[int]token.Pos // valores usados, para reportar repetidos
+	breakable *Loop
+}
+
+// currentSwitch regresa el switch más interno, o nil fuera de un switch.
+func currentSwitch(ctx *Context) *Switch {
+	if len(ctx.Switches) == 0 {
+		return nil
+	}
+	return ctx.Switches[len(ctx.Switches)-1]
+}
+
+// ProcessSwitchStart procesa `switch (expr)`: copia el valor a un temporal
+// para evaluarlo una sola vez y abre el switch. Un switch se compila como una
+// cadena de comparaciones:
+//
+//	=      expr, , t
+//	==     t, 1, c1        (case 1:)
+//	GOTOF  c1, , sig1
+//	...cuerpo...
+//	GOTO   , , fin
+//	==     t, 2, c2        (sig1: case 2:)
+//	GOTOF  c2, , sig2
+//	...
+//	...default...          (sig2)
+//
+// No hay fallthrough: cada cuerpo salta al final, igual que un break.
+func ProcessSwitchStart(ctx *Context, pos token.Pos) error {
+	value, ok := ctx.OperandStack.Pop()
+	if !ok {
+		return internalError("no hay expresión para switch")
+	}
+	valueType, _ := ctx.TypeStack.Pop()
+
+	sw := &Switch{NextCase: -1, Cases: make(map[int]token.Pos)}
+	switch valueType {
+	case TypeInt:
+		sw.Valid = true
+		sw.Value = ctx.NewTemp()
+		generateQuadruple(ctx, "=", value, "", sw.Value)
+	case TypeInvalid:
+	default:
+		ctx.Report(NewDiagnostic(CodeSwitchType, pos, 1, "la expresión de switch debe ser int, se obtuvo %s", valueType))
+	}
+
+	openLoop(ctx, -1)
+	sw.breakable = currentLoop(ctx)
+	sw.breakable.Switch = true
+	ctx.Switches = append(ctx.Switches, sw)
+	return nil
+}
+
+// endCase cierra el cuerpo del case anterior con un salto al final y dirige
+// su comparación fallida al índice actual.
+func endCase(ctx *Context, sw *Switch) {
+	if sw.InBody {
+		sw.breakable.Breaks = append(sw.breakable.Breaks, ctx.Quadruples.NextIndex())
+		generateQuadruple(ctx, "GOTO", "", "", "")
+	}
+	if sw.NextCase >= 0 {
+		fillJump(ctx, sw.NextCase, ctx.Quadruples.NextIndex())
+		sw.NextCase = -1
+	}
+}
+
+// ProcessCase procesa `case valor:`; pos y width señalan el valor.
+func ProcessCase(ctx *Context, value int, pos token.Pos, width int) error {
+	sw := currentSwitch(ctx)
+	if sw == nil {
+		return internalError("case fuera de un switch")
+	}
+	endCase(ctx, sw)
+	sw.InBody = true
+
+	if _, exists := sw.Cases[value]; exists {
+		ctx.Report(NewDiagnostic(CodeDuplicateCase, pos, width, "el valor %d ya se usó en otro case", value))
+	} else {
+		sw.Cases[value] = pos
+	}
+	if !sw.Valid {
+		return nil
+	}
+
+	matches := ctx.NewTemp()
+	generateQuadruple(ctx, "==", sw.Value, constantOperand(ctx, value), matches)
+	sw.NextCase = ctx.Quadruples.NextIndex()
+	generateQuadruple(ctx, "GOTOF", matches, "", "")
+	return nil
+}
+
+// ProcessDefault procesa `default:`, al que llegan todas las comparaciones
+// fallidas.
+func ProcessDefault(ctx *Context) error {
+	sw := currentSwitch(ctx)
+	if sw == nil {
+		return internalError("default fuera de un switch")
+	}
+	endCase(ctx, sw)
+	sw.InBody = true
+	return nil
+}
+
+// ProcessSwitchEnd completa la última comparación y todos los saltos de
+// salida (fin de cada case y break) con el índice siguiente al switch.
+func ProcessSwitchEnd(ctx *Context) error {
+	sw := currentSwitch(ctx)
+	if sw == nil {
+		return internalError("no hay switch abierto")
+	}
+	if sw.NextCase >= 0 {
+		fillJump(ctx, sw.NextCase, ctx.Quadruples.NextIndex())
+	}
+	ctx.Switches = ctx.Switches[:len(ctx.Switches)-1]
+	if currentLoop(ctx) != sw.breakable {
+		return internalError("switch cerrado fuera de orden")
+	}
+	return closeLoop(ctx)
+}
@@ -5,7 +5,8 @@ import "fmt"
 // Type encodes the semantic type system supported by Patito at this stage.
 // The values match the classic Patito types introduced en la fase semántica:
 // enteros, flotantes, void (para funciones), booleanos (resultado de relacionales)
-// y strings (para literales impresos).
+// strings y caracteres. Los valores se serializan en el .patitoc, así que los
+// tipos nuevos se agregan al final.
 type Type int
 
 const (
@@ -15,6 +16,8 @@ const (
 	TypeVoid
 	TypeBool
 	TypeString
+	TypeChar
+	TypeRecord // variable de un tipo declarado con `type T = record {...}`
 )
 
 func (t Type) String() string {
@@ -29,6 +32,10 @@ func (t Type) String() string {
 		return "bool"
 	case TypeString:
 		return "string"
+	case TypeChar:
+		return "char"
+	case TypeRecord:
+		return "record"
 	default:
 		return "invalid"
 	}
@@ -44,6 +51,12 @@ func TypeFromKeyword(keyword string) (Type, error) {
 		return TypeFloat, nil
 	case "void":
 		return TypeVoid, nil
+	case "bool":
+		return TypeBool, nil
+	case "string":
+		return TypeString, nil
+	case "char":
+		return TypeChar, nil
 	default:
 		return TypeInvalid, fmt.Errorf("tipo semántico desconocido: %q", keyword)
 	}
@@ -0,0 +1,256 @@
+package semantic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Los valores de Patito se representan como int64, float64, bool, string o
+// rune (char). Las operaciones de este archivo las comparten la máquina
+// virtual y el cálculo de constantes en compilación.
+
+// ErrDivisionByZero se reporta cuando `/` o `%` reciben un divisor cero.
+var ErrDivisionByZero = errors.New("división entre cero")
+
+// ParseValue convierte el texto de una constante (como en la tabla de
+// constantes) a su valor.
+func ParseValue(text string, t Type) (interface{}, error) {
+	switch t {
+	case TypeInt:
+		return strconv.ParseInt(text, 10, 64)
+	case TypeFloat:
+		return strconv.ParseFloat(text, 64)
+	case TypeBool:
+		return strconv.ParseBool(text)
+	case TypeString:
+		return text, nil
+	case TypeChar:
+		r, size := utf8.DecodeRuneInString(text)
+		if r == utf8.RuneError || size != len(text) {
+			return nil, fmt.Errorf("constante char %q debe ser un solo carácter", text)
+		}
+		return r, nil
+	default:
+		return nil, fmt.Errorf("constante %q con tipo no soportado %s", text, t)
+	}
+}
+
+// FormatValue da la representación de un valor que usan PRINT y la tabla de
+// constantes.
+func FormatValue(v interface{}) string {
+	switch val := v.(type) {
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	case string:
+		return val
+	case rune:
+		return string(val)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// CoerceValue adapta un valor al tipo declarado del destino (promoción
+// int->float).
+func CoerceValue(v interface{}, t Type) interface{} {
+	if i, ok := v.(int64); ok && t == TypeFloat {
+		return float64(i)
+	}
+	return v
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+// EvalArithmetic aplica `+`, `-`, `*`, `/` o `%`. Dos int dan int, `+` entre
+// strings concatena y cualquier otra combinación numérica se hace en float.
+func EvalArithmetic(op string, left, right interface{}) (interface{}, error) {
+	li, lInt := left.(int64)
+	ri, rInt := right.(int64)
+	ls, lStr := left.(string)
+	rs, rStr := right.(string)
+	if lStr && rStr && op == "+" {
+		return ls + rs, nil
+	}
+	if lInt && rInt {
+		switch op {
+		case "+":
+			return li + ri, nil
+		case "-":
+			return li - ri, nil
+		case "*":
+			return li * ri, nil
+		case "/":
+			if ri == 0 {
+				return nil, ErrDivisionByZero
+			}
+			return li / ri, nil
+		case "%":
+			if ri == 0 {
+				return nil, ErrDivisionByZero
+			}
+			return li % ri, nil
+		}
+	}
+
+	lf, ok1 := toFloat(left)
+	rf, ok2 := toFloat(right)
+	if ok1 && ok2 {
+		switch op {
+		case "+":
+			return lf + rf, nil
+		case "-":
+			return lf - rf, nil
+		case "*":
+			return lf * rf, nil
+		case "/":
+			if rf == 0 {
+				return nil, ErrDivisionByZero
+			}
+			return lf / rf, nil
+		}
+	}
+	return nil, fmt.Errorf("operandos inválidos para %s: %T, %T", op, left, right)
+}
+
+// EvalRelational aplica un operador de comparación. Los números se comparan
+// como float, los char por su código Unicode y los demás valores sólo con
+// `==` y `!=`.
+func EvalRelational(op string, left, right interface{}) (bool, error) {
+	if c, ok := left.(rune); ok {
+		left = int64(c)
+	}
+	if c, ok := right.(rune); ok {
+		right = int64(c)
+	}
+
+	lf, ok1 := toFloat(left)
+	rf, ok2 := toFloat(right)
+	switch {
+	case ok1 && ok2:
+		switch op {
+		case ">":
+			return lf > rf, nil
+		case "<":
+			return lf < rf, nil
+		case ">=":
+			return lf >= rf, nil
+		case "<=":
+			return lf <= rf, nil
+		case "!=":
+			return lf != rf, nil
+		case "==":
+			return lf == rf, nil
+		}
+	case op == "==":
+		return left == right, nil
+	case op == "!=":
+		return left != right, nil
+	}
+	return false, fmt.Errorf("operandos inválidos para %s: %T, %T", op, left, right)
+}
+
+// EvalUnary aplica `u+`, `u-` o `!`.
+func EvalUnary(op string, v interface{}) (interface{}, error) {
+	switch n := v.(type) {
+	case int64:
+		if op == "u-" {
+			return -n, nil
+		}
+		if op == "u+" {
+			return n, nil
+		}
+	case float64:
+		if op == "u-" {
+			return -n, nil
+		}
+		if op == "u+" {
+			return n, nil
+		}
+	case bool:
+		if op == "!" {
+			return !n, nil
+		}
+	}
+	return nil, fmt.Errorf("operando inválido para %s: %T", op, v)
+}
+
+// EvalBuiltin aplica el cuádruplo de una función predefinida (LEN, ORD, CHR,
+// INT, FLOAT, ROUND, FLOOR, CEIL o ABS).
+func EvalBuiltin(op string, v interface{}) (interface{}, error) {
+	switch op {
+	case "LEN":
+		if s, ok := v.(string); ok {
+			return int64(utf8.RuneCountInString(s)), nil
+		}
+	case "ORD":
+		if c, ok := v.(rune); ok {
+			return int64(c), nil
+		}
+	case "CHR":
+		if code, ok := v.(int64); ok {
+			if code < 0 || code > unicode.MaxRune || !utf8.ValidRune(rune(code)) {
+				return nil, fmt.Errorf("chr: %d no es un código Unicode válido", code)
+			}
+			return rune(code), nil
+		}
+	case "FLOAT":
+		if f, ok := toFloat(v); ok {
+			return f, nil
+		}
+	case "INT", "ROUND", "FLOOR", "CEIL":
+		switch n := v.(type) {
+		case int64:
+			return n, nil
+		case float64:
+			return floatToInt(op, n)
+		}
+	case "ABS":
+		switch n := v.(type) {
+		case int64:
+			if n < 0 {
+				return -n, nil
+			}
+			return n, nil
+		case float64:
+			return math.Abs(n), nil
+		}
+	}
+	return nil, fmt.Errorf("operando inválido para %s: %T", op, v)
+}
+
+// floatToInt redondea según op (INT trunca hacia cero) y verifica que el
+// resultado quepa en un int.
+func floatToInt(op string, f float64) (interface{}, error) {
+	switch op {
+	case "INT":
+		f = math.Trunc(f)
+	case "ROUND":
+		f = math.Round(f)
+	case "FLOOR":
+		f = math.Floor(f)
+	case "CEIL":
+		f = math.Ceil(f)
+	}
+	if math.IsNaN(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return nil, fmt.Errorf("%s: %v no cabe en un int", strings.ToLower(op), f)
+	}
+	return int64(f), nil
+}
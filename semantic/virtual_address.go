@@ -10,12 +10,16 @@ type VirtualAddressManager struct {
 	localCounter    int // 10000-19999
 	temporalCounter int // 20000-29999
 	constantCounter int // 30000-39999
+	stringCounter   int // 40000-49999
+	charCounter     int // 50000-59999
 
 	// Rangos base
 	GlobalBase   int
 	LocalBase    int
 	TemporalBase int
 	ConstantBase int
+	StringBase   int
+	CharBase     int
 }
 
 // NewVirtualAddressManager crea un nuevo gestor de direcciones virtuales
@@ -25,51 +29,91 @@ func NewVirtualAddressManager() *VirtualAddressManager {
 		LocalBase:       10000,
 		TemporalBase:    20000,
 		ConstantBase:    30000,
+		StringBase:      40000,
+		CharBase:        50000,
 		globalCounter:   1000,
 		localCounter:    10000,
 		temporalCounter: 20000,
 		constantCounter: 30000,
+		stringCounter:   40000,
+		charCounter:     50000,
 	}
 }
 
 // NextGlobal asigna la siguiente dirección global
-func (vam *VirtualAddressManager) NextGlobal() int {
-	addr := vam.globalCounter
-	vam.globalCounter++
-	if vam.globalCounter > 9999 {
-		panic("se excedió el rango de direcciones globales")
-	}
-	return addr
+func (vam *VirtualAddressManager) NextGlobal() (int, error) {
+	return vam.NextGlobalBlock(1)
+}
+
+// NextGlobalBlock reserva size direcciones globales contiguas (un arreglo) y
+// devuelve la primera
+func (vam *VirtualAddressManager) NextGlobalBlock(size int) (int, error) {
+	return reserve(&vam.globalCounter, size, 9999, SegmentGlobal)
+}
+
+// GlobalAvailable devuelve cuántas direcciones globales quedan por asignar
+func (vam *VirtualAddressManager) GlobalAvailable() int {
+	return 9999 - vam.globalCounter
 }
 
 // NextLocal asigna la siguiente dirección local
-func (vam *VirtualAddressManager) NextLocal() int {
-	addr := vam.localCounter
-	vam.localCounter++
-	if vam.localCounter > 19999 {
-		panic("se excedió el rango de direcciones locales")
-	}
-	return addr
+func (vam *VirtualAddressManager) NextLocal() (int, error) {
+	return vam.NextLocalBlock(1)
+}
+
+// NextLocalBlock reserva size direcciones locales contiguas y devuelve la primera
+func (vam *VirtualAddressManager) NextLocalBlock(size int) (int, error) {
+	return reserve(&vam.localCounter, size, 19999, SegmentLocal)
+}
+
+// LocalAvailable devuelve cuántas direcciones locales quedan por asignar
+func (vam *VirtualAddressManager) LocalAvailable() int {
+	return 19999 - vam.localCounter
 }
 
 // NextTemporal asigna la siguiente dirección temporal
-func (vam *VirtualAddressManager) NextTemporal() int {
-	addr := vam.temporalCounter
-	vam.temporalCounter++
-	if vam.temporalCounter > 29999 {
-		panic("se excedió el rango de direcciones temporales")
-	}
-	return addr
+func (vam *VirtualAddressManager) NextTemporal() (int, error) {
+	return reserve(&vam.temporalCounter, 1, 29999, SegmentTemporal)
 }
 
 // NextConstant asigna la siguiente dirección de constante
-func (vam *VirtualAddressManager) NextConstant() int {
-	addr := vam.constantCounter
-	vam.constantCounter++
-	if vam.constantCounter > 39999 {
-		panic("se excedió el rango de direcciones de constantes")
+func (vam *VirtualAddressManager) NextConstant() (int, error) {
+	return reserve(&vam.constantCounter, 1, 39999, SegmentConstant)
+}
+
+// NextString asigna la siguiente dirección del segmento de literales string.
+// Las variables string usan los segmentos global, local y temporal como las
+// demás; este segmento sólo guarda las constantes.
+func (vam *VirtualAddressManager) NextString() (int, error) {
+	return reserve(&vam.stringCounter, 1, 49999, SegmentString)
+}
+
+// NextChar asigna la siguiente dirección del segmento de literales char; como
+// con los strings, las variables char viven en los segmentos de su ámbito.
+func (vam *VirtualAddressManager) NextChar() (int, error) {
+	return reserve(&vam.charCounter, 1, 59999, SegmentChar)
+}
+
+// reserve avanza counter size direcciones y devuelve la primera. Si pasan de
+// limit regresa SegmentOverflowError sin reservar nada.
+func reserve(counter *int, size, limit int, segment Segment) (int, error) {
+	if *counter+size > limit {
+		return 0, &SegmentOverflowError{Segment: segment}
 	}
-	return addr
+	addr := *counter
+	*counter += size
+	return addr, nil
+}
+
+// LocalMark devuelve la siguiente dirección local que se asignaría.
+func (vam *VirtualAddressManager) LocalMark() int {
+	return vam.localCounter
+}
+
+// ReleaseLocals libera las direcciones locales asignadas desde mark (al
+// cerrar un bloque) para que se reutilicen.
+func (vam *VirtualAddressManager) ReleaseLocals(mark int) {
+	vam.localCounter = mark
 }
 
 // ResetLocals reinicia el contador de locales (al entrar a una nueva función)
@@ -86,3 +130,56 @@ func (vam *VirtualAddressManager) ResetTemporals() {
 func AddressToString(addr int) string {
 	return fmt.Sprintf("%d", addr)
 }
+
+// Segment identifica el bloque de memoria al que pertenece una dirección virtual.
+type Segment int
+
+const (
+	SegmentInvalid Segment = iota
+	SegmentGlobal
+	SegmentLocal
+	SegmentTemporal
+	SegmentConstant
+	SegmentString
+	SegmentChar
+)
+
+func (s Segment) String() string {
+	switch s {
+	case SegmentGlobal:
+		return "global"
+	case SegmentLocal:
+		return "local"
+	case SegmentTemporal:
+		return "temporal"
+	case SegmentConstant:
+		return "constante"
+	case SegmentString:
+		return "string"
+	case SegmentChar:
+		return "char"
+	default:
+		return "inválido"
+	}
+}
+
+// SegmentOf devuelve el segmento de una dirección virtual usando los mismos
+// rangos que asigna VirtualAddressManager.
+func SegmentOf(addr int) Segment {
+	switch {
+	case addr >= 1000 && addr <= 9999:
+		return SegmentGlobal
+	case addr >= 10000 && addr <= 19999:
+		return SegmentLocal
+	case addr >= 20000 && addr <= 29999:
+		return SegmentTemporal
+	case addr >= 30000 && addr <= 39999:
+		return SegmentConstant
+	case addr >= 40000 && addr <= 49999:
+		return SegmentString
+	case addr >= 50000 && addr <= 59999:
+		return SegmentChar
+	default:
+		return SegmentInvalid
+	}
+}
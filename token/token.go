@@ -144,36 +144,70 @@ var TokMap = TokenMap{
 		"main",
 		"end",
 		"empty",
-		"var",
+		"type",
+		"=",
+		"record",
+		"{",
+		"}",
 		":",
+		"var",
+		"error",
+		"const",
 		",",
+		"[",
+		"cte_int",
+		"]",
 		"int",
 		"float",
+		"bool",
+		"string",
+		"char",
 		"void",
 		"(",
 		")",
-		"[",
-		"]",
-		"{",
-		"}",
+		"ref",
+		"break",
+		"continue",
 		"print",
-		"cte_string",
-		"=",
-		"while",
+		"read",
+		".",
 		"do",
+		"while",
+		"to",
+		"for",
+		"step",
 		"if",
 		"else",
+		"switch",
+		"case",
+		"-",
+		"default",
 		"return",
+		"||",
+		"&&",
 		">",
 		"<",
 		"!=",
 		"==",
+		">=",
+		"<=",
 		"+",
-		"-",
 		"*",
 		"/",
-		"cte_int",
+		"%",
+		"!",
+		"len",
+		"ord",
+		"chr",
+		"round",
+		"floor",
+		"ceil",
+		"abs",
 		"cte_float",
+		"true",
+		"false",
+		"cte_string",
+		"cte_char",
 	},
 
 	idMap: map[string]Type{
@@ -185,35 +219,69 @@ var TokMap = TokenMap{
 		"main":       5,
 		"end":        6,
 		"empty":      7,
-		"var":        8,
-		":":          9,
-		",":          10,
-		"int":        11,
-		"float":      12,
-		"void":       13,
-		"(":          14,
-		")":          15,
-		"[":          16,
-		"]":          17,
-		"{":          18,
-		"}":          19,
-		"print":      20,
-		"cte_string": 21,
-		"=":          22,
-		"while":      23,
-		"do":         24,
-		"if":         25,
-		"else":       26,
-		"return":     27,
-		">":          28,
-		"<":          29,
-		"!=":         30,
-		"==":         31,
-		"+":          32,
-		"-":          33,
-		"*":          34,
-		"/":          35,
-		"cte_int":    36,
-		"cte_float":  37,
+		"type":       8,
+		"=":          9,
+		"record":     10,
+		"{":          11,
+		"}":          12,
+		":":          13,
+		"var":        14,
+		"error":      15,
+		"const":      16,
+		",":          17,
+		"[":          18,
+		"cte_int":    19,
+		"]":          20,
+		"int":        21,
+		"float":      22,
+		"bool":       23,
+		"string":     24,
+		"char":       25,
+		"void":       26,
+		"(":          27,
+		")":          28,
+		"ref":        29,
+		"break":      30,
+		"continue":   31,
+		"print":      32,
+		"read":       33,
+		".":          34,
+		"do":         35,
+		"while":      36,
+		"to":         37,
+		"for":        38,
+		"step":       39,
+		"if":         40,
+		"else":       41,
+		"switch":     42,
+		"case":       43,
+		"-":          44,
+		"default":    45,
+		"return":     46,
+		"||":         47,
+		"&&":         48,
+		">":          49,
+		"<":          50,
+		"!=":         51,
+		"==":         52,
+		">=":         53,
+		"<=":         54,
+		"+":          55,
+		"*":          56,
+		"/":          57,
+		"%":          58,
+		"!":          59,
+		"len":        60,
+		"ord":        61,
+		"chr":        62,
+		"round":      63,
+		"floor":      64,
+		"ceil":       65,
+		"abs":        66,
+		"cte_float":  67,
+		"true":       68,
+		"false":      69,
+		"cte_string": 70,
+		"cte_char":   71,
 	},
 }
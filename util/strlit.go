@@ -0,0 +1,105 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// EscapeError describe una secuencia de escape inválida dentro de un literal
+// string. Offset y Len están en bytes, relativos al inicio del literal
+// (incluyendo la comilla inicial).
+type EscapeError struct {
+	Offset int
+	Len    int
+	Msg    string
+}
+
+func (e *EscapeError) Error() string {
+	return e.Msg
+}
+
+// StringValue decodifica el literal de un token cte_string (con comillas) a
+// su valor. Reconoce \n, \t, \", \\ y \u{h...} con 1 a 6 dígitos
+// hexadecimales; el resto del contenido se copia tal cual (UTF-8).
+func StringValue(lit []byte) (string, error) {
+	body := lit[1 : len(lit)-1]
+	var sb strings.Builder
+	sb.Grow(len(body))
+	for i := 0; i < len(body); {
+		r, size := utf8.DecodeRune(body[i:])
+		if r == utf8.RuneError && size <= 1 {
+			return "", &EscapeError{Offset: i + 1, Len: 1, Msg: "el literal no es UTF-8 válido"}
+		}
+		if r != '\\' {
+			sb.WriteRune(r)
+			i += size
+			continue
+		}
+
+		start := i
+		i++
+		if i >= len(body) {
+			return "", &EscapeError{Offset: start + 1, Len: 1, Msg: "secuencia de escape incompleta"}
+		}
+		next, nextSize := utf8.DecodeRune(body[i:])
+		i += nextSize
+		switch next {
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case '"':
+			sb.WriteByte('"')
+		case '\\':
+			sb.WriteByte('\\')
+		case 'u':
+			value, end, err := unicodeEscape(body, start, i)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteRune(value)
+			i = end
+		default:
+			return "", &EscapeError{Offset: start + 1, Len: 1 + nextSize,
+				Msg: fmt.Sprintf("secuencia de escape inválida \\%c (se aceptan \\n, \\t, \\\", \\\\ y \\u{...})", next)}
+		}
+	}
+	return sb.String(), nil
+}
+
+// unicodeEscape lee `{h...}` a partir de body[i] para la secuencia \u que
+// empieza en start. Regresa el carácter y el índice siguiente a `}`.
+func unicodeEscape(body []byte, start, i int) (rune, int, error) {
+	fail := func(end int, msg string) (rune, int, error) {
+		return 0, 0, &EscapeError{Offset: start + 1, Len: end - start, Msg: msg}
+	}
+	if i >= len(body) || body[i] != '{' {
+		return fail(i, "se esperaba \\u{...} con el código en hexadecimal")
+	}
+	i++
+	var value uint32
+	digits := 0
+	for ; i < len(body) && body[i] != '}'; i++ {
+		d := digitVal(rune(body[i]))
+		if d >= 16 {
+			return fail(i+1, fmt.Sprintf("dígito hexadecimal inválido %q en \\u{...}", body[i]))
+		}
+		digits++
+		if digits > 6 {
+			return fail(i+1, "\\u{...} acepta a lo más 6 dígitos hexadecimales")
+		}
+		value = value*16 + uint32(d)
+	}
+	if i >= len(body) {
+		return fail(len(body), "falta '}' en \\u{...}")
+	}
+	if digits == 0 {
+		return fail(i+1, "\\u{} necesita al menos un dígito hexadecimal")
+	}
+	if value > unicode.MaxRune || 0xD800 <= value && value < 0xE000 {
+		return fail(i+1, fmt.Sprintf("U+%X no es un código Unicode válido", value))
+	}
+	return rune(value), i + 1, nil
+}
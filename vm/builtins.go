@@ -0,0 +1,21 @@
+package vm
+
+import "Patito/semantic"
+
+// execBuiltin ejecuta los cuádruplos de las funciones predefinidas:
+// (OP, x, , t). El cubo semántico ya validó el tipo de x.
+func (m *Machine) execBuiltin(inst instruction) error {
+	v, err := m.read(inst.op1)
+	if err != nil {
+		return err
+	}
+	result, err := semantic.EvalBuiltin(inst.quad.Operator, v)
+	if err != nil {
+		return err
+	}
+	if err := m.write(inst.result, result); err != nil {
+		return err
+	}
+	m.ip++
+	return nil
+}
@@ -0,0 +1,70 @@
+package vm
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Disassemble escribe una vista legible de un Program: encabezado, records
+// (si hay), globales, funciones, constantes y cuádruplos.
+func Disassemble(w io.Writer, prog *Program) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "program %s\n", prog.Name)
+
+	if len(prog.Records) > 0 {
+		fmt.Fprintf(bw, "\nRecords (%d):\n", len(prog.Records))
+		for _, r := range prog.Records {
+			fmt.Fprintf(bw, "  %s\n", r.Name)
+			for _, f := range r.Fields {
+				fmt.Fprintf(bw, "    +%-3d %-6s %s\n", f.Offset, f.Type, f.Name)
+			}
+		}
+	}
+
+	fmt.Fprintf(bw, "\nGlobales (%d):\n", len(prog.Globals))
+	for _, g := range prog.Globals {
+		fmt.Fprintf(bw, "  %5d  %-6s %s\n", g.Address, g.TypeName(), g.Name)
+	}
+
+	// Las funciones se muestran en el orden en que aparecen en el código
+	functions := make([]*Function, 0, len(prog.Functions))
+	for _, fn := range prog.Functions {
+		functions = append(functions, fn)
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		if functions[i].StartQuad != functions[j].StartQuad {
+			return functions[i].StartQuad < functions[j].StartQuad
+		}
+		return functions[i].Name < functions[j].Name
+	})
+
+	fmt.Fprintf(bw, "\nFunciones (%d):\n", len(functions))
+	for _, fn := range functions {
+		fmt.Fprintf(bw, "  %s %s @%d\n", fn.ReturnType, fn.Name, fn.StartQuad)
+		for _, p := range fn.Params {
+			kind := "param"
+			if p.ByRef {
+				kind = "ref  "
+			}
+			fmt.Fprintf(bw, "    %s %5d  %-6s %s\n", kind, p.Address, p.TypeName(), p.Name)
+		}
+		for _, l := range fn.Locals {
+			fmt.Fprintf(bw, "    local %5d  %-6s %s\n", l.Address, l.TypeName(), l.Name)
+		}
+	}
+
+	fmt.Fprintf(bw, "\nConstantes (%d):\n", len(prog.Constants))
+	for _, c := range prog.Constants {
+		fmt.Fprintf(bw, "  %5d  %-6s %q\n", c.Address, c.Type, c.Value)
+	}
+
+	fmt.Fprintf(bw, "\nCuádruplos (%d):\n", len(prog.Quadruples))
+	for i, quad := range prog.Quadruples {
+		fmt.Fprintf(bw, "  %d: %s\n", i, quad.String())
+	}
+
+	return bw.Flush()
+}
@@ -0,0 +1,58 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"Patito/semantic"
+)
+
+// ErrInvalidInput se reporta cuando READ no puede convertir la entrada al
+// tipo de la variable destino o la entrada terminó.
+var ErrInvalidInput = errors.New("entrada inválida")
+
+// readInput lee el siguiente valor de la entrada para una variable del tipo
+// indicado por el cuádruplo READ. Los valores se separan con espacios o
+// saltos de línea, como en `read(x, y);` con "3 4" o "3\n4".
+func (m *Machine) readInput(typeName string) (interface{}, error) {
+	t, err := semantic.TypeFromKeyword(typeName)
+	if err != nil {
+		return nil, fmt.Errorf("READ con tipo no soportado %q", typeName)
+	}
+	text, err := m.nextToken()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: se esperaba un valor %s y la entrada terminó", ErrInvalidInput, t)
+	}
+	if err != nil {
+		return nil, err
+	}
+	v, err := semantic.ParseValue(text, t)
+	if err != nil {
+		return nil, fmt.Errorf("%w: se esperaba un valor %s, se leyó %q", ErrInvalidInput, t, text)
+	}
+	return v, nil
+}
+
+// nextToken regresa la siguiente palabra de la entrada, saltando espacios.
+func (m *Machine) nextToken() (string, error) {
+	var sb strings.Builder
+	for {
+		r, _, err := m.input.ReadRune()
+		if err == io.EOF && sb.Len() > 0 {
+			return sb.String(), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if unicode.IsSpace(r) {
+			if sb.Len() > 0 {
+				return sb.String(), nil
+			}
+			continue
+		}
+		sb.WriteRune(r)
+	}
+}
@@ -0,0 +1,509 @@
+package vm
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"Patito/semantic"
+)
+
+// ErrDivisionByZero se reporta cuando un cuádruplo `/` recibe un divisor cero.
+var ErrDivisionByZero = semantic.ErrDivisionByZero
+
+// ErrIndexOutOfRange se reporta cuando VERIFY recibe un índice fuera de los
+// límites del arreglo.
+var ErrIndexOutOfRange = errors.New("índice fuera de rango")
+
+// RuntimeError envuelve un error de ejecución con el cuádruplo que lo provocó.
+type RuntimeError struct {
+	Quad     int
+	Operator string
+	Err      error
+}
+
+func (e *RuntimeError) Error() string {
+	return fmt.Sprintf("error de ejecución en cuádruplo %d (%s): %v", e.Quad, e.Operator, e.Err)
+}
+
+func (e *RuntimeError) Unwrap() error {
+	return e.Err
+}
+
+// instruction es un cuádruplo con sus direcciones ya convertidas a enteros
+// (-1 cuando el campo está vacío o no es numérico, p. ej. nombres de función).
+// Un operando `(t)` es indirecto: la dirección real es el valor del temporal t.
+type instruction struct {
+	quad     semantic.Quadruple
+	op1      int
+	op2      int
+	result   int
+	indirect [3]bool // op1, op2, result
+}
+
+// pendingCall acumula los argumentos entre ERA y GOSUB.
+type pendingCall struct {
+	fn   *Function
+	args []interface{}
+}
+
+// Machine ejecuta un Program cuádruplo por cuádruplo con memoria segmentada
+// (global, local, temporal, constante) y un frame por llamada.
+type Machine struct {
+	// Output recibe lo que imprime PRINT; por defecto os.Stdout.
+	Output io.Writer
+	// Input es de donde lee READ; por defecto os.Stdin.
+	Input io.Reader
+	// MaxSteps limita los cuádruplos ejecutados (0 = sin límite).
+	MaxSteps int
+
+	program      *Program
+	code         []instruction
+	input        *bufio.Reader
+	globals      segment
+	globalTypes  map[int]semantic.Type
+	constants    segment
+	frames       []*Frame
+	pending      []*pendingCall
+	ip           int
+	running      bool
+	stepsCounter int
+}
+
+// NewMachine prepara una máquina para ejecutar prog.
+func NewMachine(prog *Program) *Machine {
+	code := make([]instruction, len(prog.Quadruples))
+	for i, quad := range prog.Quadruples {
+		inst := instruction{quad: quad}
+		inst.op1, inst.indirect[0] = parseOperand(quad.Operand1)
+		inst.op2, inst.indirect[1] = parseOperand(quad.Operand2)
+		inst.result, inst.indirect[2] = parseOperand(quad.Result)
+		code[i] = inst
+	}
+	return &Machine{
+		Output:  os.Stdout,
+		Input:   os.Stdin,
+		program: prog,
+		code:    code,
+	}
+}
+
+// parseOperand convierte un campo del cuádruplo en dirección e indica si es
+// indirecto (`(t)`).
+func parseOperand(s string) (int, bool) {
+	indirect := semantic.IsPointerOperand(s)
+	if indirect {
+		s = s[1 : len(s)-1]
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return -1, false
+	}
+	return n, indirect
+}
+
+// Steps devuelve cuántos cuádruplos ejecutó la última llamada a Run.
+func (m *Machine) Steps() int {
+	return m.stepsCounter
+}
+
+// Run ejecuta el programa desde el cuádruplo 0 hasta END.
+func (m *Machine) Run() error {
+	if err := m.reset(); err != nil {
+		return err
+	}
+
+	for m.running {
+		if m.ip < 0 || m.ip >= len(m.code) {
+			return &RuntimeError{Quad: m.ip, Operator: "GOTO", Err: fmt.Errorf("salto fuera del programa")}
+		}
+		if m.MaxSteps > 0 && m.stepsCounter >= m.MaxSteps {
+			return &RuntimeError{Quad: m.ip, Operator: m.code[m.ip].quad.Operator, Err: fmt.Errorf("se excedió el límite de %d pasos", m.MaxSteps)}
+		}
+		current := m.ip
+		m.stepsCounter++
+		if err := m.step(); err != nil {
+			return &RuntimeError{Quad: current, Operator: m.code[current].quad.Operator, Err: err}
+		}
+	}
+	return nil
+}
+
+func (m *Machine) reset() error {
+	m.globals = make(segment)
+	m.globalTypes = make(map[int]semantic.Type)
+	m.constants = make(segment)
+	m.frames = []*Frame{newFrame(nil, -1, -1)}
+	m.pending = nil
+	m.ip = 0
+	m.running = true
+	m.stepsCounter = 0
+	m.input = bufio.NewReader(m.Input)
+
+	for _, g := range m.program.Globals {
+		g.cells(func(addr int, t semantic.Type) {
+			m.globals[addr] = defaultValue(t)
+			m.globalTypes[addr] = t
+		})
+	}
+	for _, c := range m.program.Constants {
+		value, err := semantic.ParseValue(c.Value, c.Type)
+		if err != nil {
+			return err
+		}
+		m.constants[c.Address] = value
+	}
+	return nil
+}
+
+func (m *Machine) frame() *Frame {
+	return m.frames[len(m.frames)-1]
+}
+
+// read obtiene el valor guardado en una dirección virtual.
+func (m *Machine) read(addr int) (interface{}, error) {
+	var seg segment
+	switch semantic.SegmentOf(addr) {
+	case semantic.SegmentGlobal:
+		seg = m.globals
+	case semantic.SegmentLocal:
+		ref := m.local(addr)
+		if ref.frame == nil {
+			seg = m.globals
+		} else {
+			seg = ref.frame.locals
+		}
+		addr = ref.addr
+	case semantic.SegmentTemporal:
+		seg = m.frame().temps
+	case semantic.SegmentConstant, semantic.SegmentString, semantic.SegmentChar:
+		seg = m.constants
+	default:
+		return nil, fmt.Errorf("dirección inválida %d", addr)
+	}
+	if v, ok := seg[addr]; ok {
+		return v, nil
+	}
+	if t, ok := m.program.TypeMap[addr]; ok && defaultValue(t) != nil {
+		return defaultValue(t), nil
+	}
+	return nil, fmt.Errorf("lectura de la dirección %d sin valor", addr)
+}
+
+// write guarda un valor, promoviéndolo al tipo declarado del destino si aplica.
+func (m *Machine) write(addr int, v interface{}) error {
+	switch semantic.SegmentOf(addr) {
+	case semantic.SegmentGlobal:
+		m.globals[addr] = semantic.CoerceValue(v, m.globalTypes[addr])
+	case semantic.SegmentLocal:
+		ref := m.local(addr)
+		if ref.frame == nil {
+			m.globals[ref.addr] = semantic.CoerceValue(v, m.globalTypes[ref.addr])
+		} else {
+			ref.frame.locals[ref.addr] = semantic.CoerceValue(v, ref.frame.types[ref.addr])
+		}
+	case semantic.SegmentTemporal:
+		m.frame().temps[addr] = v
+	case semantic.SegmentConstant, semantic.SegmentString, semantic.SegmentChar:
+		return fmt.Errorf("no se puede escribir en la constante %d", addr)
+	default:
+		return fmt.Errorf("dirección inválida %d", addr)
+	}
+	return nil
+}
+
+// local devuelve la celda de una dirección local del frame actual; si es un
+// parámetro ref, la del llamador.
+func (m *Machine) local(addr int) reference {
+	frame := m.frame()
+	if ref, ok := frame.refs[addr]; ok {
+		return ref
+	}
+	return reference{frame: frame, addr: addr}
+}
+
+// resolve sustituye los operandos indirectos por la dirección que guardan.
+func (m *Machine) resolve(inst instruction) (instruction, error) {
+	fields := [3]*int{&inst.op1, &inst.op2, &inst.result}
+	for i, indirect := range inst.indirect {
+		if !indirect {
+			continue
+		}
+		v, err := m.read(*fields[i])
+		if err != nil {
+			return inst, err
+		}
+		addr, ok := v.(int64)
+		if !ok {
+			return inst, fmt.Errorf("el temporal %d no contiene una dirección", *fields[i])
+		}
+		*fields[i] = int(addr)
+	}
+	return inst, nil
+}
+
+func (m *Machine) step() error {
+	inst, err := m.resolve(m.code[m.ip])
+	if err != nil {
+		return err
+	}
+	switch inst.quad.Operator {
+	case "+", "-", "*", "/", "%":
+		return m.execArithmetic(inst)
+	case ">", "<", ">=", "<=", "!=", "==":
+		return m.execRelational(inst)
+	case "=":
+		v, err := m.read(inst.op1)
+		if err != nil {
+			return err
+		}
+		if err := m.write(inst.result, v); err != nil {
+			return err
+		}
+	case "u+", "u-", "!":
+		return m.execUnary(inst)
+	case "VERIFY":
+		v, err := m.read(inst.op1)
+		if err != nil {
+			return err
+		}
+		index, ok := v.(int64)
+		if !ok {
+			return fmt.Errorf("VERIFY requiere un índice int, obtuvo %T", v)
+		}
+		// Los límites son literales (inst.op2 e inst.result), no direcciones
+		if index < int64(inst.op2) || index > int64(inst.result) {
+			return fmt.Errorf("%w: %d no está en [%d, %d]", ErrIndexOutOfRange, index, inst.op2, inst.result)
+		}
+	case "GOTO":
+		m.ip = inst.result
+		return nil
+	case "GOTOF", "GOTOV":
+		v, err := m.read(inst.op1)
+		if err != nil {
+			return err
+		}
+		cond, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("%s requiere un valor booleano, obtuvo %T", inst.quad.Operator, v)
+		}
+		// GOTOF salta si la condición es falsa; GOTOV, si es verdadera
+		if cond == (inst.quad.Operator == "GOTOV") {
+			m.ip = inst.result
+			return nil
+		}
+	case "PRINT":
+		v, err := m.read(inst.op1)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(m.Output, semantic.FormatValue(v)); err != nil {
+			return err
+		}
+	case "LEN", "ORD", "CHR", "INT", "FLOAT", "ROUND", "FLOOR", "CEIL", "ABS":
+		return m.execBuiltin(inst)
+	case "READ":
+		v, err := m.readInput(inst.quad.Operand1)
+		if err != nil {
+			return err
+		}
+		if err := m.write(inst.result, v); err != nil {
+			return err
+		}
+	case "INIT":
+		// Las variables de bloque son locales del frame actual; los límites son
+		// el tipo (operand1) y la cantidad de celdas (operand2, literal)
+		t, err := semantic.TypeFromKeyword(inst.quad.Operand1)
+		if err != nil {
+			return fmt.Errorf("INIT con tipo no soportado %q", inst.quad.Operand1)
+		}
+		if semantic.SegmentOf(inst.result) != semantic.SegmentLocal {
+			return fmt.Errorf("INIT requiere una dirección local, obtuvo %d", inst.result)
+		}
+		frame := m.frame()
+		for addr := inst.result; addr < inst.result+inst.op2; addr++ {
+			frame.types[addr] = t
+			frame.locals[addr] = defaultValue(t)
+		}
+	case "ERA":
+		fn, ok := m.program.Functions[inst.quad.Operand1]
+		if !ok {
+			return fmt.Errorf("función '%s' no encontrada", inst.quad.Operand1)
+		}
+		m.pending = append(m.pending, &pendingCall{fn: fn})
+	case "PARAM":
+		if len(m.pending) == 0 {
+			return fmt.Errorf("PARAM sin ERA previo")
+		}
+		v, err := m.read(inst.op1)
+		if err != nil {
+			return err
+		}
+		call := m.pending[len(m.pending)-1]
+		call.args = append(call.args, v)
+	case "PARAMREF":
+		if len(m.pending) == 0 {
+			return fmt.Errorf("PARAMREF sin ERA previo")
+		}
+		var ref reference
+		switch semantic.SegmentOf(inst.op1) {
+		case semantic.SegmentGlobal:
+			ref = reference{addr: inst.op1}
+		case semantic.SegmentLocal:
+			ref = m.local(inst.op1)
+		default:
+			return fmt.Errorf("PARAMREF requiere la dirección de una variable, obtuvo %d", inst.op1)
+		}
+		call := m.pending[len(m.pending)-1]
+		call.args = append(call.args, ref)
+	case "GOSUB":
+		return m.execGosub(inst)
+	case "RETURN":
+		return m.execReturn(inst)
+	case "ENDFUNC":
+		return m.execEndFunc()
+	case "END":
+		m.running = false
+		return nil
+	default:
+		return fmt.Errorf("operador desconocido %q", inst.quad.Operator)
+	}
+	m.ip++
+	return nil
+}
+
+func (m *Machine) operands(inst instruction) (interface{}, interface{}, error) {
+	left, err := m.read(inst.op1)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err := m.read(inst.op2)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+func (m *Machine) execArithmetic(inst instruction) error {
+	left, right, err := m.operands(inst)
+	if err != nil {
+		return err
+	}
+	result, err := semantic.EvalArithmetic(inst.quad.Operator, left, right)
+	if err != nil {
+		return err
+	}
+	if err := m.write(inst.result, result); err != nil {
+		return err
+	}
+	m.ip++
+	return nil
+}
+
+func (m *Machine) execRelational(inst instruction) error {
+	left, right, err := m.operands(inst)
+	if err != nil {
+		return err
+	}
+	result, err := semantic.EvalRelational(inst.quad.Operator, left, right)
+	if err != nil {
+		return err
+	}
+	if err := m.write(inst.result, result); err != nil {
+		return err
+	}
+	m.ip++
+	return nil
+}
+
+func (m *Machine) execUnary(inst instruction) error {
+	v, err := m.read(inst.op1)
+	if err != nil {
+		return err
+	}
+	result, err := semantic.EvalUnary(inst.quad.Operator, v)
+	if err != nil {
+		return err
+	}
+	if err := m.write(inst.result, result); err != nil {
+		return err
+	}
+	m.ip++
+	return nil
+}
+
+func (m *Machine) execGosub(inst instruction) error {
+	if len(m.pending) == 0 {
+		return fmt.Errorf("GOSUB sin ERA previo")
+	}
+	call := m.pending[len(m.pending)-1]
+	m.pending = m.pending[:len(m.pending)-1]
+
+	fn := call.fn
+	if fn.Name != inst.quad.Operand1 {
+		return fmt.Errorf("GOSUB a '%s' no corresponde al ERA de '%s'", inst.quad.Operand1, fn.Name)
+	}
+	if len(call.args) != len(fn.Params) {
+		return fmt.Errorf("función '%s' esperaba %d argumentos, recibió %d", fn.Name, len(fn.Params), len(call.args))
+	}
+	if fn.StartQuad < 0 || fn.StartQuad >= len(m.code) {
+		return fmt.Errorf("función '%s' no tiene cuádruplo de inicio válido", fn.Name)
+	}
+
+	frame := newFrame(fn, m.ip+1, inst.result)
+	for i, param := range fn.Params {
+		ref, isRef := call.args[i].(reference)
+		if param.ByRef != isRef {
+			return fmt.Errorf("el parámetro '%s' de '%s' no coincide con el modo del argumento (PARAM/PARAMREF)", param.Name, fn.Name)
+		}
+		if isRef {
+			frame.refs[param.Address] = ref
+			continue
+		}
+		frame.locals[param.Address] = semantic.CoerceValue(call.args[i], param.Type)
+	}
+	m.frames = append(m.frames, frame)
+	m.ip = fn.StartQuad
+	return nil
+}
+
+// popFrame regresa al llamador y, si hay valor, lo escribe en su temporal.
+func (m *Machine) popFrame(value interface{}, hasValue bool) error {
+	frame := m.frame()
+	m.frames = m.frames[:len(m.frames)-1]
+	if hasValue && frame.ResultAddr >= 0 {
+		if err := m.write(frame.ResultAddr, semantic.CoerceValue(value, frame.Function.ReturnType)); err != nil {
+			return err
+		}
+	}
+	m.ip = frame.ReturnIP
+	return nil
+}
+
+func (m *Machine) execReturn(inst instruction) error {
+	if len(m.frames) < 2 {
+		return fmt.Errorf("RETURN fuera de una función")
+	}
+	if inst.quad.Operand1 == "" {
+		return m.popFrame(nil, false)
+	}
+	v, err := m.read(inst.op1)
+	if err != nil {
+		return err
+	}
+	return m.popFrame(v, true)
+}
+
+func (m *Machine) execEndFunc() error {
+	if len(m.frames) < 2 {
+		return fmt.Errorf("ENDFUNC fuera de una función")
+	}
+	fn := m.frame().Function
+	if fn.ReturnType != semantic.TypeVoid {
+		return fmt.Errorf("función '%s' terminó sin ejecutar return", fn.Name)
+	}
+	return m.popFrame(nil, false)
+}
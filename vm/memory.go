@@ -0,0 +1,67 @@
+package vm
+
+import "Patito/semantic"
+
+// Los valores en memoria se guardan como int64, float64, bool, string o rune
+// (char) según el tipo semántico de la dirección.
+type segment map[int]interface{}
+
+// Frame es el registro de activación de una llamada. Cada llamada tiene su
+// propia memoria local y temporal, por lo que la recursión no pisa los valores
+// del llamador.
+type Frame struct {
+	Function   *Function // nil para main
+	ReturnIP   int       // cuádruplo al que se regresa
+	ResultAddr int       // temporal del llamador que recibe el valor de retorno (-1 si no hay)
+
+	locals segment
+	temps  segment
+	types  map[int]semantic.Type // tipos declarados de parámetros y locales
+	refs   map[int]reference     // parámetros ref: dirección local -> celda del llamador
+}
+
+// reference es la celda a la que apunta un parámetro ref: una dirección
+// local de frame o, con frame nil, una dirección global.
+type reference struct {
+	frame *Frame
+	addr  int
+}
+
+func newFrame(fn *Function, returnIP, resultAddr int) *Frame {
+	frame := &Frame{
+		Function:   fn,
+		ReturnIP:   returnIP,
+		ResultAddr: resultAddr,
+		locals:     make(segment),
+		temps:      make(segment),
+		types:      make(map[int]semantic.Type),
+		refs:       make(map[int]reference),
+	}
+	if fn != nil {
+		for _, v := range append(append([]Variable(nil), fn.Params...), fn.Locals...) {
+			v.cells(func(addr int, t semantic.Type) {
+				frame.types[addr] = t
+				frame.locals[addr] = defaultValue(t)
+			})
+		}
+	}
+	return frame
+}
+
+// defaultValue regresa el valor inicial de una variable de tipo t.
+func defaultValue(t semantic.Type) interface{} {
+	switch t {
+	case semantic.TypeInt:
+		return int64(0)
+	case semantic.TypeFloat:
+		return float64(0)
+	case semantic.TypeBool:
+		return false
+	case semantic.TypeString:
+		return ""
+	case semantic.TypeChar:
+		return rune(0)
+	default:
+		return nil
+	}
+}
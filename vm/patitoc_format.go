@@ -6,11 +6,18 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
+)
+
+// Modo de paso de un parámetro en la tabla de funciones
+const (
+	paramByValue uint8 = 0
+	paramByRef   uint8 = 1
 )
 
 const (
 	PATITOC_MAGIC   = 0x50415449 // "PATI" en ASCII
-	PATITOC_VERSION = 1
+	PATITOC_VERSION = 4          // v2 agrega las dimensiones de cada variable; v3, los tipos record; v4, el modo de paso de los parámetros
 )
 
 type PatitocWriter struct {
@@ -18,7 +25,9 @@ type PatitocWriter struct {
 }
 
 type PatitocReader struct {
-	r io.Reader
+	r       io.Reader
+	version uint16
+	records map[string]*Record
 }
 
 // Header del archivo
@@ -40,10 +49,14 @@ func SavePatitoc(ctx *semantic.Context, filename string) error {
 	defer file.Close()
 
 	writer := &PatitocWriter{w: file}
-	fmt.Print("Writing .patitoc file")
 	return writer.Write(ctx)
 }
 
+// NewPatitocWriter crea un escritor sobre w.
+func NewPatitocWriter(w io.Writer) *PatitocWriter {
+	return &PatitocWriter{w: w}
+}
+
 func (pw *PatitocWriter) Write(ctx *semantic.Context) error {
 	quads := ctx.Quadruples.Get()
 	constants := ctx.ConstantTable.Entries()
@@ -54,7 +67,7 @@ func (pw *PatitocWriter) Write(ctx *semantic.Context) error {
 		Version:     PATITOC_VERSION,
 		QuadCount:   uint32(len(quads)),
 		ConstCount:  uint32(len(constants)),
-		FuncCount:   uint32(len(ctx.Directory.Functions)),
+		FuncCount:   uint32(len(ctx.Directory.OrderedFunctions())),
 		GlobalCount: uint32(len(ctx.Directory.Globals.Entries())),
 	}
 
@@ -68,13 +81,18 @@ func (pw *PatitocWriter) Write(ctx *semantic.Context) error {
 		return err
 	}
 
+	// 2b. Escribir tipos record
+	if err := pw.writeRecords(ctx.Directory.OrderedRecords()); err != nil {
+		return err
+	}
+
 	// 3. Escribir variables globales
 	if err := pw.writeGlobals(ctx.Directory.Globals); err != nil {
 		return err
 	}
 
 	// 4. Escribir funciones
-	if err := pw.writeFunctions(ctx.Directory.Functions, ctx.FunctionStartQuads); err != nil {
+	if err := pw.writeFunctions(ctx.Directory.OrderedFunctions(), ctx.FunctionStartQuads); err != nil {
 		return err
 	}
 
@@ -109,25 +127,76 @@ func (pw *PatitocWriter) writeString(s []byte) error {
 	return nil
 }
 
-func (pw *PatitocWriter) writeGlobals(globals *semantic.VariableTable) error {
-	entries := globals.Entries()
-	for _, entry := range entries {
-		// Escribir: nombre, tipo, dirección
-		if err := pw.writeString([]byte(entry.Name)); err != nil {
+// writeRecords escribe la cantidad de tipos record (uint16) y, por cada uno,
+// su nombre y sus campos: cantidad uint16 y nombre, tipo y desplazamiento de
+// cada campo.
+func (pw *PatitocWriter) writeRecords(records []*semantic.RecordType) error {
+	if err := binary.Write(pw.w, binary.LittleEndian, uint16(len(records))); err != nil {
+		return err
+	}
+	for _, record := range records {
+		if err := pw.writeString([]byte(record.Name)); err != nil {
 			return err
 		}
-		if err := binary.Write(pw.w, binary.LittleEndian, uint8(entry.Type)); err != nil {
+		if err := binary.Write(pw.w, binary.LittleEndian, uint16(len(record.Fields))); err != nil {
 			return err
 		}
-		if err := binary.Write(pw.w, binary.LittleEndian, uint32(entry.Address)); err != nil {
+		for _, field := range record.Fields {
+			if err := pw.writeString([]byte(field.Name)); err != nil {
+				return err
+			}
+			if err := binary.Write(pw.w, binary.LittleEndian, uint8(field.Type)); err != nil {
+				return err
+			}
+			if err := binary.Write(pw.w, binary.LittleEndian, uint32(field.Offset)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (pw *PatitocWriter) writeGlobals(globals *semantic.VariableTable) error {
+	entries := globals.Entries()
+	for _, entry := range entries {
+		if err := pw.writeVariable(entry); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (pw *PatitocWriter) writeFunctions(functions map[string]*semantic.FunctionEntry, startQuads map[string]int) error {
-	for name, fn := range functions {
+// writeVariable escribe nombre, tipo, dirección, dimensiones (cantidad
+// uint8 seguida de un uint32 por dimensión; 0 para escalares) y el nombre
+// del tipo record (vacío si no es record).
+func (pw *PatitocWriter) writeVariable(entry *semantic.VariableEntry) error {
+	if err := pw.writeString([]byte(entry.Name)); err != nil {
+		return err
+	}
+	if err := binary.Write(pw.w, binary.LittleEndian, uint8(entry.Type)); err != nil {
+		return err
+	}
+	if err := binary.Write(pw.w, binary.LittleEndian, uint32(entry.Address)); err != nil {
+		return err
+	}
+	if err := binary.Write(pw.w, binary.LittleEndian, uint8(len(entry.Dims))); err != nil {
+		return err
+	}
+	for _, d := range entry.Dims {
+		if err := binary.Write(pw.w, binary.LittleEndian, uint32(d)); err != nil {
+			return err
+		}
+	}
+	recordName := ""
+	if entry.Record != nil {
+		recordName = entry.Record.Name
+	}
+	return pw.writeString([]byte(recordName))
+}
+
+func (pw *PatitocWriter) writeFunctions(functions []*semantic.FunctionEntry, startQuads map[*semantic.FunctionEntry]int) error {
+	for _, fn := range functions {
+		name := fn.Symbol
 		// Nombre de función
 		if err := pw.writeString([]byte(name)); err != nil {
 			return err
@@ -140,7 +209,7 @@ func (pw *PatitocWriter) writeFunctions(functions map[string]*semantic.FunctionE
 
 		// Índice de inicio
 		startQuad := -1
-		if sq, ok := startQuads[name]; ok {
+		if sq, ok := startQuads[fn]; ok {
 			startQuad = sq
 		}
 		if err := binary.Write(pw.w, binary.LittleEndian, int32(startQuad)); err != nil {
@@ -153,13 +222,14 @@ func (pw *PatitocWriter) writeFunctions(functions map[string]*semantic.FunctionE
 			return err
 		}
 		for _, param := range params {
-			if err := pw.writeString([]byte(param.Name)); err != nil {
+			if err := pw.writeVariable(param); err != nil {
 				return err
 			}
-			if err := binary.Write(pw.w, binary.LittleEndian, uint8(param.Type)); err != nil {
-				return err
+			mode := paramByValue
+			if param.ByRef {
+				mode = paramByRef
 			}
-			if err := binary.Write(pw.w, binary.LittleEndian, uint32(param.Address)); err != nil {
+			if err := binary.Write(pw.w, binary.LittleEndian, mode); err != nil {
 				return err
 			}
 		}
@@ -170,13 +240,7 @@ func (pw *PatitocWriter) writeFunctions(functions map[string]*semantic.FunctionE
 			return err
 		}
 		for _, local := range locals {
-			if err := pw.writeString([]byte(local.Name)); err != nil {
-				return err
-			}
-			if err := binary.Write(pw.w, binary.LittleEndian, uint8(local.Type)); err != nil {
-				return err
-			}
-			if err := binary.Write(pw.w, binary.LittleEndian, uint32(local.Address)); err != nil {
+			if err := pw.writeVariable(local); err != nil {
 				return err
 			}
 		}
@@ -230,21 +294,27 @@ func (pw *PatitocWriter) writeQuadruples(quads []semantic.Quadruple) error {
 }
 
 func (pw *PatitocWriter) writeTypeMap(ctx *semantic.Context) error {
-	// Construir mapa de tipos (dirección -> tipo)
+	// Construir mapa de tipos (dirección -> tipo); los arreglos y records
+	// registran cada una de sus celdas
 	typeMap := make(map[uint32]uint8)
+	addVariable := func(entry *semantic.VariableEntry) {
+		for i := 0; i < entry.Size(); i++ {
+			typeMap[uint32(entry.Address+i)] = uint8(entry.CellType(i))
+		}
+	}
 
 	// Globales
 	for _, entry := range ctx.Directory.Globals.Entries() {
-		typeMap[uint32(entry.Address)] = uint8(entry.Type)
+		addVariable(entry)
 	}
 
 	// Funciones
-	for _, fn := range ctx.Directory.Functions {
+	for _, fn := range ctx.Directory.OrderedFunctions() {
 		for _, param := range fn.Params.Entries() {
-			typeMap[uint32(param.Address)] = uint8(param.Type)
+			addVariable(param)
 		}
 		for _, local := range fn.Locals.Entries() {
-			typeMap[uint32(local.Address)] = uint8(local.Type)
+			addVariable(local)
 		}
 	}
 
@@ -258,15 +328,343 @@ func (pw *PatitocWriter) writeTypeMap(ctx *semantic.Context) error {
 		return err
 	}
 
-	// Escribir entradas
-	for addr, t := range typeMap {
+	// Escribir entradas ordenadas por dirección para que la salida sea reproducible
+	addrs := make([]uint32, 0, len(typeMap))
+	for addr := range typeMap {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return addrs[i] < addrs[j] })
+
+	for _, addr := range addrs {
 		if err := binary.Write(pw.w, binary.LittleEndian, addr); err != nil {
 			return err
 		}
-		if err := binary.Write(pw.w, binary.LittleEndian, t); err != nil {
+		if err := binary.Write(pw.w, binary.LittleEndian, typeMap[addr]); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// LoadPatitoc abre un archivo .patitoc y lo carga como Program.
+func LoadPatitoc(filename string) (*Program, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return NewPatitocReader(file).Read()
+}
+
+// NewPatitocReader crea un lector sobre r.
+func NewPatitocReader(r io.Reader) *PatitocReader {
+	return &PatitocReader{r: r}
+}
+
+// Read decodifica un programa completo en el mismo orden en que lo escribe
+// PatitocWriter.Write: header, nombre, records, globales, funciones,
+// constantes, cuádruplos y mapa de tipos.
+func (pr *PatitocReader) Read() (*Program, error) {
+	// 1. Header
+	var header PatitocHeader
+	if err := pr.read(&header, "header"); err != nil {
+		return nil, err
+	}
+	if header.Magic != PATITOC_MAGIC {
+		return nil, fmt.Errorf("patitoc: número mágico inválido 0x%08X (se esperaba 0x%08X)", header.Magic, PATITOC_MAGIC)
+	}
+	// La versión 1 no tiene dimensiones en las variables, la 2 no tiene
+	// tipos record y la 3 no tiene el modo de paso de los parámetros
+	if header.Version < 1 || header.Version > PATITOC_VERSION {
+		return nil, fmt.Errorf("patitoc: versión %d no soportada (se esperaba de 1 a %d)", header.Version, PATITOC_VERSION)
+	}
+	pr.version = header.Version
+
+	prog := &Program{
+		Functions: make(map[string]*Function),
+		TypeMap:   make(map[int]semantic.Type),
+	}
+
+	// 2. Nombre del programa
+	name, err := pr.readString("nombre del programa")
+	if err != nil {
+		return nil, err
+	}
+	prog.Name = name
+
+	// 2b. Tipos record
+	pr.records = make(map[string]*Record)
+	if pr.version >= 3 {
+		if err := pr.readRecords(prog); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. Variables globales
+	for i := uint32(0); i < header.GlobalCount; i++ {
+		v, err := pr.readVariable(fmt.Sprintf("global %d", i))
+		if err != nil {
+			return nil, err
+		}
+		prog.Globals = append(prog.Globals, v)
+	}
+
+	// 4. Funciones
+	for i := uint32(0); i < header.FuncCount; i++ {
+		fn, err := pr.readFunction(i)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := prog.Functions[fn.Name]; exists {
+			return nil, fmt.Errorf("patitoc: función %q duplicada", fn.Name)
+		}
+		prog.Functions[fn.Name] = fn
+	}
+
+	// 5. Constantes
+	for i := uint32(0); i < header.ConstCount; i++ {
+		what := fmt.Sprintf("constante %d", i)
+		t, err := pr.readType(what)
+		if err != nil {
+			return nil, err
+		}
+		var addr uint32
+		if err := pr.read(&addr, what); err != nil {
+			return nil, err
+		}
+		value, err := pr.readString(what)
+		if err != nil {
+			return nil, err
+		}
+		prog.Constants = append(prog.Constants, Constant{Type: t, Address: int(addr), Value: value})
+	}
+
+	// 6. Cuádruplos
+	for i := uint32(0); i < header.QuadCount; i++ {
+		var fields [4]string
+		for j := range fields {
+			s, err := pr.readString(fmt.Sprintf("cuádruplo %d", i))
+			if err != nil {
+				return nil, err
+			}
+			fields[j] = s
+		}
+		prog.Quadruples = append(prog.Quadruples, semantic.Quadruple{
+			Operator: fields[0],
+			Operand1: fields[1],
+			Operand2: fields[2],
+			Result:   fields[3],
+		})
+	}
+
+	// 7. Mapa de tipos
+	var typeCount uint32
+	if err := pr.read(&typeCount, "mapa de tipos"); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < typeCount; i++ {
+		what := fmt.Sprintf("entrada %d del mapa de tipos", i)
+		var addr uint32
+		if err := pr.read(&addr, what); err != nil {
+			return nil, err
+		}
+		t, err := pr.readType(what)
+		if err != nil {
+			return nil, err
+		}
+		prog.TypeMap[int(addr)] = t
+	}
+
+	// No deben quedar bytes después del mapa de tipos
+	var extra [1]byte
+	if n, _ := pr.r.Read(extra[:]); n > 0 {
+		return nil, fmt.Errorf("patitoc: datos sobrantes después del mapa de tipos")
+	}
+
+	return prog, nil
+}
+
+// read lee un valor little-endian; what describe la sección para el mensaje de error.
+func (pr *PatitocReader) read(data interface{}, what string) error {
+	if err := binary.Read(pr.r, binary.LittleEndian, data); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return fmt.Errorf("patitoc: archivo truncado al leer %s: %w", what, io.ErrUnexpectedEOF)
+		}
+		return fmt.Errorf("patitoc: error al leer %s: %w", what, err)
+	}
+	return nil
+}
+
+func (pr *PatitocReader) readString(what string) (string, error) {
+	var length uint16
+	if err := pr.read(&length, what); err != nil {
+		return "", err
+	}
+	if length == 0 {
+		return "", nil
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(pr.r, buf); err != nil {
+		return "", fmt.Errorf("patitoc: archivo truncado al leer %s: %w", what, io.ErrUnexpectedEOF)
+	}
+	return string(buf), nil
+}
+
+func (pr *PatitocReader) readType(what string) (semantic.Type, error) {
+	var raw uint8
+	if err := pr.read(&raw, what); err != nil {
+		return semantic.TypeInvalid, err
+	}
+	t := semantic.Type(raw)
+	if t == semantic.TypeInvalid || t.String() == "invalid" {
+		return semantic.TypeInvalid, fmt.Errorf("patitoc: tipo %d desconocido en %s", raw, what)
+	}
+	return t, nil
+}
+
+func (pr *PatitocReader) readRecords(prog *Program) error {
+	var recordCount uint16
+	if err := pr.read(&recordCount, "tipos record"); err != nil {
+		return err
+	}
+	for i := uint16(0); i < recordCount; i++ {
+		name, err := pr.readString(fmt.Sprintf("record %d", i))
+		if err != nil {
+			return err
+		}
+		what := fmt.Sprintf("record %q", name)
+		if _, exists := pr.records[name]; exists {
+			return fmt.Errorf("patitoc: %s duplicado", what)
+		}
+		var fieldCount uint16
+		if err := pr.read(&fieldCount, what); err != nil {
+			return err
+		}
+		record := &Record{Name: name}
+		for j := uint16(0); j < fieldCount; j++ {
+			fieldName, err := pr.readString(what)
+			if err != nil {
+				return err
+			}
+			t, err := pr.readType(what)
+			if err != nil {
+				return err
+			}
+			var offset uint32
+			if err := pr.read(&offset, what); err != nil {
+				return err
+			}
+			record.Fields = append(record.Fields, Field{Name: fieldName, Type: t, Offset: int(offset)})
+		}
+		prog.Records = append(prog.Records, record)
+		pr.records[name] = record
+	}
+	return nil
+}
+
+func (pr *PatitocReader) readVariable(what string) (Variable, error) {
+	name, err := pr.readString(what)
+	if err != nil {
+		return Variable{}, err
+	}
+	t, err := pr.readType(what)
+	if err != nil {
+		return Variable{}, err
+	}
+	var addr uint32
+	if err := pr.read(&addr, what); err != nil {
+		return Variable{}, err
+	}
+	v := Variable{Name: name, Type: t, Address: int(addr)}
+	if pr.version < 2 {
+		return v, nil
+	}
+
+	var dimCount uint8
+	if err := pr.read(&dimCount, what); err != nil {
+		return Variable{}, err
+	}
+	for i := uint8(0); i < dimCount; i++ {
+		var d uint32
+		if err := pr.read(&d, what); err != nil {
+			return Variable{}, err
+		}
+		v.Dims = append(v.Dims, int(d))
+	}
+	if pr.version < 3 {
+		return v, nil
+	}
+
+	recordName, err := pr.readString(what)
+	if err != nil {
+		return Variable{}, err
+	}
+	if recordName != "" {
+		record, ok := pr.records[recordName]
+		if !ok {
+			return Variable{}, fmt.Errorf("patitoc: record %q desconocido en %s", recordName, what)
+		}
+		v.Record = record
+	}
+	return v, nil
+}
+
+func (pr *PatitocReader) readFunction(index uint32) (*Function, error) {
+	what := fmt.Sprintf("función %d", index)
+	name, err := pr.readString(what)
+	if err != nil {
+		return nil, err
+	}
+	what = fmt.Sprintf("función %q", name)
+
+	returnType, err := pr.readType(what)
+	if err != nil {
+		return nil, err
+	}
+	var startQuad int32
+	if err := pr.read(&startQuad, what); err != nil {
+		return nil, err
+	}
+	fn := &Function{Name: name, ReturnType: returnType, StartQuad: int(startQuad)}
+
+	var paramCount uint16
+	if err := pr.read(&paramCount, what); err != nil {
+		return nil, err
+	}
+	for i := uint16(0); i < paramCount; i++ {
+		paramWhat := fmt.Sprintf("parámetro %d de %s", i, what)
+		v, err := pr.readVariable(paramWhat)
+		if err != nil {
+			return nil, err
+		}
+		if pr.version >= 4 {
+			var mode uint8
+			if err := pr.read(&mode, paramWhat); err != nil {
+				return nil, err
+			}
+			switch mode {
+			case paramByValue:
+			case paramByRef:
+				v.ByRef = true
+			default:
+				return nil, fmt.Errorf("patitoc: modo de paso %d desconocido en %s", mode, paramWhat)
+			}
+		}
+		fn.Params = append(fn.Params, v)
+	}
+
+	var localCount uint16
+	if err := pr.read(&localCount, what); err != nil {
+		return nil, err
+	}
+	for i := uint16(0); i < localCount; i++ {
+		v, err := pr.readVariable(fmt.Sprintf("local %d de %s", i, what))
+		if err != nil {
+			return nil, err
+		}
+		fn.Locals = append(fn.Locals, v)
+	}
+	return fn, nil
+}
@@ -0,0 +1,172 @@
+package vm
+
+import (
+	"fmt"
+
+	"Patito/semantic"
+)
+
+// Program es la representación en memoria de un programa compilado: la misma
+// información que se serializa en un archivo .patitoc.
+type Program struct {
+	Name       string
+	Globals    []Variable
+	Functions  map[string]*Function
+	Constants  []Constant
+	Quadruples []semantic.Quadruple
+	TypeMap    map[int]semantic.Type
+	Records    []*Record // tipos record en orden de declaración
+}
+
+// Record describe un tipo record: cada campo ocupa la celda Offset a partir
+// de la dirección de la variable.
+type Record struct {
+	Name   string
+	Fields []Field
+}
+
+// Field es un campo de un Record.
+type Field struct {
+	Name   string
+	Type   semantic.Type
+	Offset int
+}
+
+// Variable describe una variable global, parámetro o local. Los arreglos y
+// records ocupan Size() direcciones contiguas a partir de Address.
+type Variable struct {
+	Name    string
+	Type    semantic.Type
+	Address int
+	Dims    []int   // nil para escalares
+	Record  *Record // nil salvo en variables record
+	ByRef   bool    // parámetro por referencia
+}
+
+// Size devuelve cuántas celdas ocupa la variable.
+func (v Variable) Size() int {
+	if v.Record != nil {
+		return len(v.Record.Fields)
+	}
+	return semantic.ArraySize(v.Dims)
+}
+
+// TypeName describe el tipo declarado, p. ej. "int", "float[3][4]" o el
+// nombre del record.
+func (v Variable) TypeName() string {
+	if v.Record != nil {
+		return v.Record.Name
+	}
+	name := v.Type.String()
+	for _, d := range v.Dims {
+		name += fmt.Sprintf("[%d]", d)
+	}
+	return name
+}
+
+// cells llama fn con la dirección y el tipo de cada celda de la variable.
+func (v Variable) cells(fn func(addr int, t semantic.Type)) {
+	if v.Record != nil {
+		for _, f := range v.Record.Fields {
+			fn(v.Address+f.Offset, f.Type)
+		}
+		return
+	}
+	for i := 0; i < v.Size(); i++ {
+		fn(v.Address+i, v.Type)
+	}
+}
+
+// Function describe una función del programa y el cuádruplo donde inicia.
+type Function struct {
+	Name       string
+	ReturnType semantic.Type
+	StartQuad  int
+	Params     []Variable
+	Locals     []Variable
+}
+
+// Constant es una entrada de la tabla de constantes; el valor se guarda como
+// texto y se interpreta según su tipo al cargarlo en memoria.
+type Constant struct {
+	Type    semantic.Type
+	Address int
+	Value   string
+}
+
+// ProgramFromContext construye un Program a partir del contexto semántico
+// que deja el parser, sin pasar por un archivo .patitoc.
+func ProgramFromContext(ctx *semantic.Context) *Program {
+	prog := &Program{
+		Name:       ctx.Directory.ProgramName,
+		Functions:  make(map[string]*Function),
+		Quadruples: append([]semantic.Quadruple(nil), ctx.Quadruples.Get()...),
+		TypeMap:    make(map[int]semantic.Type),
+	}
+
+	records := make(map[string]*Record)
+	for _, rt := range ctx.Directory.OrderedRecords() {
+		record := &Record{Name: rt.Name}
+		for _, f := range rt.Fields {
+			record.Fields = append(record.Fields, Field{Name: f.Name, Type: f.Type, Offset: f.Offset})
+		}
+		prog.Records = append(prog.Records, record)
+		records[record.Name] = record
+	}
+	variable := func(entry *semantic.VariableEntry) Variable {
+		v := Variable{
+			Name:    entry.Name,
+			Type:    entry.Type,
+			Address: entry.Address,
+			Dims:    entry.Dims,
+			ByRef:   entry.ByRef,
+		}
+		if entry.Record != nil {
+			v.Record = records[entry.Record.Name]
+		}
+		addTypes(prog.TypeMap, v)
+		return v
+	}
+
+	for _, entry := range ctx.Directory.Globals.Entries() {
+		prog.Globals = append(prog.Globals, variable(entry))
+	}
+
+	for _, fn := range ctx.Directory.OrderedFunctions() {
+		name := fn.Symbol
+		startQuad := -1
+		if sq, ok := ctx.FunctionStartQuads[fn]; ok {
+			startQuad = sq
+		}
+		function := &Function{
+			Name:       name,
+			ReturnType: fn.ReturnType,
+			StartQuad:  startQuad,
+		}
+		for _, param := range fn.Params.Entries() {
+			function.Params = append(function.Params, variable(param))
+		}
+		for _, local := range fn.Locals.Entries() {
+			function.Locals = append(function.Locals, variable(local))
+		}
+		prog.Functions[name] = function
+	}
+
+	for _, entry := range ctx.ConstantTable.Entries() {
+		prog.Constants = append(prog.Constants, Constant{
+			Type:    entry.Type,
+			Address: entry.Address,
+			Value:   entry.Value,
+		})
+		prog.TypeMap[entry.Address] = entry.Type
+	}
+
+	return prog
+}
+
+// addTypes registra el tipo de cada celda de una variable en el mapa de tipos.
+func addTypes(typeMap map[int]semantic.Type, v Variable) {
+	v.cells(func(addr int, t semantic.Type) {
+		typeMap[addr] = t
+	})
+}